@@ -7,11 +7,15 @@ import (
 	"github.com/webitel/webitel-go-kit/infra/profiler"
 
 	"github.com/webitel/im-contact-service/config"
+	"github.com/webitel/im-contact-service/infra/cache"
 	"github.com/webitel/im-contact-service/infra/pubsub"
+	"github.com/webitel/im-contact-service/infra/ratelimit"
+	gatewaysrv "github.com/webitel/im-contact-service/infra/server/gateway"
 	grpcsrv "github.com/webitel/im-contact-service/infra/server/grpc"
 	"github.com/webitel/im-contact-service/infra/tls"
 	grpchandler "github.com/webitel/im-contact-service/internal/handler/grpc"
 	"github.com/webitel/im-contact-service/internal/service"
+	"github.com/webitel/im-contact-service/internal/store/cached"
 	"github.com/webitel/im-contact-service/internal/store/postgres"
 )
 
@@ -27,14 +31,22 @@ func MainModule(cfg *config.Config) fx.Option {
 			ProvideSD,
 			ProvideNewDBConnection,
 			ProvideProfiler,
+			fx.Annotate(
+				ProvideDBHealthCheck,
+				fx.ResultTags(`group:"health_checks"`),
+			),
 		),
 		fx.Invoke(func(_ discovery.DiscoveryProvider) error { return nil }),
 		tls.Module,
 		fx.Invoke(ProvideRuntimeMetrics),
 		pubsub.Module,
+		cache.Module,
+		ratelimit.Module,
 		postgres.Module,
+		cached.Decorators,
 		service.Module,
 		grpcsrv.Module,
+		gatewaysrv.Module,
 		grpchandler.Module,
 		profiler.Module,
 	)
@@ -24,6 +24,7 @@ import (
 
 	"github.com/webitel/im-contact-service/config"
 	"github.com/webitel/im-contact-service/infra/db/pg"
+	"github.com/webitel/im-contact-service/infra/health"
 	"github.com/webitel/im-contact-service/internal/model"
 
 	_ "github.com/webitel/webitel-go-kit/infra/discovery/consul" // register consul discovery driver
@@ -247,8 +248,10 @@ func ProvideSD(cfg *config.Config, log *slog.Logger, lc fx.Lifecycle) (discovery
 
 func ProvideNewDBConnection(cfg *config.Config, l *slog.Logger, lc fx.Lifecycle) (*pg.PgxDB, error) {
 	config := pg.ConnectionConfig{
-		DSN:         cfg.Postgres.DSN,
-		OTELEnabled: cfg.Log.Otel,
+		DSN:                  cfg.Postgres.DSN,
+		OTELEnabled:          cfg.Log.Otel,
+		ReplicaDSNs:          cfg.Postgres.Replicas,
+		ReplicaCheckInterval: cfg.Postgres.ReplicaCheckInterval,
 	}
 
 	db, err := pg.New(context.Background(), l, config)
@@ -258,7 +261,7 @@ func ProvideNewDBConnection(cfg *config.Config, l *slog.Logger, lc fx.Lifecycle)
 
 	lc.Append(fx.Hook{
 		OnStop: func(_ context.Context) error {
-			db.Master().Close()
+			db.Close()
 
 			return nil
 		},
@@ -267,6 +270,11 @@ func ProvideNewDBConnection(cfg *config.Config, l *slog.Logger, lc fx.Lifecycle)
 	return db, err
 }
 
+// ProvideDBHealthCheck reports Postgres as unavailable while the master doesn't answer pings.
+func ProvideDBHealthCheck(db *pg.PgxDB) health.Check {
+	return health.Check{Name: health.Postgres, Probe: db.Ping}
+}
+
 func ProvideProfiler(cfg *config.Config, log *slog.Logger) (profiler.Config, logger.Logger) {
 	return profiler.Config{
 		Addr:                 cfg.Profiler.Addr,
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log/slog"
 	"strings"
+	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/spf13/pflag"
@@ -11,13 +12,21 @@ import (
 )
 
 type Config struct {
-	Service  ServiceConfig      `mapstructure:"service"`
-	Log      appconfig.Log      `mapstructure:"log"`
-	Postgres appconfig.Postgres `mapstructure:"postgres"`
-	Redis    appconfig.Redis    `mapstructure:"redis"`
-	Consul   appconfig.Consul   `mapstructure:"consul"`
-	Pubsub   appconfig.Pubsub   `mapstructure:"pubsub"`
-	Profiler appconfig.Profiler `mapstructure:"profiler"`
+	Service      ServiceConfig      `mapstructure:"service"`
+	Log          appconfig.Log      `mapstructure:"log"`
+	Postgres     PostgresConfig     `mapstructure:"postgres"`
+	Redis        appconfig.Redis    `mapstructure:"redis"`
+	Cache        CacheConfig        `mapstructure:"cache"`
+	Limits       LimitsConfig       `mapstructure:"limits"`
+	Verification VerificationConfig `mapstructure:"verification"`
+	Delivery     DeliveryConfig     `mapstructure:"delivery"`
+	Suspension   SuspensionConfig   `mapstructure:"suspension"`
+	Auth         AuthConfig         `mapstructure:"auth"`
+	Health       HealthConfig       `mapstructure:"health"`
+	Gateway      GatewayConfig      `mapstructure:"gateway"`
+	Consul       appconfig.Consul   `mapstructure:"consul"`
+	Pubsub       appconfig.Pubsub   `mapstructure:"pubsub"`
+	Profiler     appconfig.Profiler `mapstructure:"profiler"`
 }
 
 type ServiceConfig struct {
@@ -25,6 +34,70 @@ type ServiceConfig struct {
 	Connection appconfig.GRPCConn `mapstructure:"conn"`
 }
 
+type PostgresConfig struct {
+	appconfig.Postgres `mapstructure:",squash"`
+
+	// Replicas is a list of read-only standby DSNs used for search and lookup queries.
+	Replicas             []string      `mapstructure:"replicas"`
+	ReplicaCheckInterval time.Duration `mapstructure:"replica_check_interval"`
+}
+
+// CacheConfig defines how long lookups stay in Redis before they are read from the database again.
+type CacheConfig struct {
+	ContactTTL  time.Duration `mapstructure:"contact_ttl"`
+	SettingsTTL time.Duration `mapstructure:"settings_ttl"`
+}
+
+// LimitsConfig defines per-sender rate limits. A zero limit disables the check.
+type LimitsConfig struct {
+	// InvitesPerWindow is the invite burst a sender may spend; tokens refill evenly over InviteWindow.
+	InvitesPerWindow int           `mapstructure:"invites_per_window"`
+	InviteWindow     time.Duration `mapstructure:"invite_window"`
+}
+
+// VerificationConfig defines one-time codes used to verify vias. Zero values fall back to defaults.
+type VerificationConfig struct {
+	CodeTTL     time.Duration `mapstructure:"code_ttl"`
+	MaxAttempts int           `mapstructure:"max_attempts"`
+	CodeLength  int           `mapstructure:"code_length"`
+	ResendAfter time.Duration `mapstructure:"resend_after"`
+}
+
+// DeliveryConfig defines how failed deliveries reported by the message pipeline affect vias.
+type DeliveryConfig struct {
+	// FailureThreshold is the failure count that disables a via; zero only counts failures.
+	FailureThreshold int `mapstructure:"failure_threshold"`
+}
+
+// SuspensionConfig defines how often suspended vias are checked for re-enabling. A zero interval turns the check off.
+type SuspensionConfig struct {
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+	BatchSize     int           `mapstructure:"batch_size"`
+}
+
+// AuthConfig defines how callers are authenticated. Callers with a verified client certificate
+// are accepted as trusted services; everyone else needs a bearer token signed with TokenSecret.
+type AuthConfig struct {
+	TokenSecret string `mapstructure:"token_secret"`
+}
+
+// HealthConfig defines how often the dependencies behind the gRPC health statuses are checked.
+// DrainDelay is how long the server keeps serving after reporting NOT_SERVING on shutdown,
+// so that balancers see the status before the listener is closed.
+type HealthConfig struct {
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+	CheckTimeout  time.Duration `mapstructure:"check_timeout"`
+	DrainDelay    time.Duration `mapstructure:"drain_delay"`
+}
+
+// GatewayConfig defines the HTTP/JSON gateway in front of the gRPC services. An empty address turns it off.
+type GatewayConfig struct {
+	Addr string `mapstructure:"addr"`
+}
+
+// minTokenSecretLength is the shortest HS256 secret accepted; shorter secrets can be brute-forced offline.
+const minTokenSecretLength = 32
+
 // LoadServerConfig loads the full configuration required by the gRPC server.
 func LoadServerConfig() (*Config, error) {
 	loader := appconfig.NewLoader(appconfig.Sections{
@@ -37,6 +110,15 @@ func LoadServerConfig() (*Config, error) {
 	})
 	loader.RegisterFlags(pflag.CommandLine)
 	registerServiceFlags()
+	registerPostgresFlags()
+	registerCacheFlags()
+	registerLimitsFlags()
+	registerVerificationFlags()
+	registerDeliveryFlags()
+	registerSuspensionFlags()
+	registerAuthFlags()
+	registerHealthFlags()
+	registerGatewayFlags()
 	pflag.Parse()
 
 	cfg := &Config{}
@@ -92,6 +174,51 @@ func registerServiceFlags() {
 	appconfig.RegisterGRPCConnFlags(pflag.CommandLine, "service.conn", true)
 }
 
+func registerPostgresFlags() {
+	pflag.String("postgres.replicas", "", "Comma-separated read replica DSNs (reads go to master when empty)")
+	pflag.Duration("postgres.replica_check_interval", 5*time.Second, "Read replica health check interval")
+}
+
+func registerCacheFlags() {
+	pflag.Duration("cache.contact_ttl", 5*time.Minute, "TTL of cached contacts")
+	pflag.Duration("cache.settings_ttl", 5*time.Minute, "TTL of cached contact settings")
+}
+
+func registerLimitsFlags() {
+	pflag.Int("limits.invites_per_window", 100, "Invites a sender may check per window (0 disables the limit)")
+	pflag.Duration("limits.invite_window", time.Hour, "Window over which the invite limit refills")
+}
+
+func registerVerificationFlags() {
+	pflag.Duration("verification.code_ttl", 10*time.Minute, "How long a via verification code stays valid")
+	pflag.Int("verification.max_attempts", 5, "Wrong codes accepted before a verification is burned")
+	pflag.Int("verification.code_length", 6, "Digits in a via verification code")
+	pflag.Duration("verification.resend_after", time.Minute, "How long before a new verification code may be sent to the same via")
+}
+
+func registerDeliveryFlags() {
+	pflag.Int("delivery.failure_threshold", 5, "Delivery failures that disable a via (0 never disables)")
+}
+
+func registerSuspensionFlags() {
+	pflag.Duration("suspension.check_interval", 30*time.Second, "How often suspended vias are checked for re-enabling (0 disables)")
+	pflag.Int("suspension.batch_size", 100, "Vias re-enabled per statement")
+}
+
+func registerAuthFlags() {
+	pflag.String("auth.token_secret", "", "HS256 secret of caller access tokens, at least 32 bytes (empty accepts only mTLS callers)")
+}
+
+func registerHealthFlags() {
+	pflag.Duration("health.check_interval", 10*time.Second, "How often Postgres and the broker are checked for the gRPC health statuses")
+	pflag.Duration("health.check_timeout", 3*time.Second, "Timeout of a single health check")
+	pflag.Duration("health.drain_delay", 5*time.Second, "How long the server keeps serving after reporting NOT_SERVING on shutdown")
+}
+
+func registerGatewayFlags() {
+	pflag.String("gateway.addr", "", "HTTP/JSON gateway listen address (empty disables the gateway)")
+}
+
 func (c *Config) validate() error {
 	if c.Service.Addr == "" {
 		return fmt.Errorf("config: service.addr is required")
@@ -99,6 +226,12 @@ func (c *Config) validate() error {
 	if err := appconfig.ValidateGRPCConn("service.conn", c.Service.Connection); err != nil {
 		return err
 	}
+	if c.Auth.TokenSecret == "" && !c.Service.Connection.VerifyCerts {
+		return fmt.Errorf("config: auth.token_secret is required when service.conn.verify_certs is off")
+	}
+	if c.Auth.TokenSecret != "" && len(c.Auth.TokenSecret) < minTokenSecretLength {
+		return fmt.Errorf("config: auth.token_secret must be at least %d bytes", minTokenSecretLength)
+	}
 	if c.Log.Level == "" {
 		c.Log.Level = "info"
 	}
@@ -108,6 +241,39 @@ func (c *Config) validate() error {
 	if c.Redis.Addr == "" {
 		return fmt.Errorf("config: redis.addr is required")
 	}
+	if c.Limits.InvitesPerWindow < 0 {
+		return fmt.Errorf("config: limits.invites_per_window must not be negative")
+	}
+	if c.Limits.InvitesPerWindow > 0 && c.Limits.InviteWindow <= 0 {
+		return fmt.Errorf("config: limits.invite_window must be positive")
+	}
+	if c.Verification.CodeLength > 12 {
+		return fmt.Errorf("config: verification.code_length must not exceed 12")
+	}
+	if c.Verification.MaxAttempts < 0 {
+		return fmt.Errorf("config: verification.max_attempts must not be negative")
+	}
+	if c.Delivery.FailureThreshold < 0 {
+		return fmt.Errorf("config: delivery.failure_threshold must not be negative")
+	}
+	if c.Suspension.CheckInterval < 0 {
+		return fmt.Errorf("config: suspension.check_interval must not be negative")
+	}
+	if c.Suspension.BatchSize < 0 {
+		return fmt.Errorf("config: suspension.batch_size must not be negative")
+	}
+	if c.Health.CheckInterval <= 0 {
+		return fmt.Errorf("config: health.check_interval must be positive")
+	}
+	if c.Health.CheckTimeout <= 0 || c.Health.CheckTimeout > c.Health.CheckInterval {
+		return fmt.Errorf("config: health.check_timeout must be positive and not exceed health.check_interval")
+	}
+	if c.Health.DrainDelay < 0 {
+		return fmt.Errorf("config: health.drain_delay must not be negative")
+	}
+	if c.Gateway.Addr != "" && c.Gateway.Addr == c.Service.Addr {
+		return fmt.Errorf("config: gateway.addr must differ from service.addr")
+	}
 	if c.Consul.Addr == "" {
 		return fmt.Errorf("config: consul.addr is required")
 	}
@@ -26,6 +26,7 @@ const (
 	Contacts_Patch_FullMethodName         = "/webitel.im.service.contact.v1.Contacts/Patch"
 	Contacts_Upsert_FullMethodName        = "/webitel.im.service.contact.v1.Contacts/Upsert"
 	Contacts_Locate_FullMethodName        = "/webitel.im.service.contact.v1.Contacts/Locate"
+	Contacts_ResolveByVia_FullMethodName  = "/webitel.im.service.contact.v1.Contacts/ResolveByVia"
 )
 
 // ContactsClient is the client API for Contacts service.
@@ -39,6 +40,7 @@ type ContactsClient interface {
 	Patch(ctx context.Context, in *PatchContactRequest, opts ...grpc.CallOption) (*Contact, error)
 	Upsert(ctx context.Context, in *CreateContactRequest, opts ...grpc.CallOption) (*Contact, error)
 	Locate(ctx context.Context, in *LocateContactRequest, opts ...grpc.CallOption) (*LocateContactResponse, error)
+	ResolveByVia(ctx context.Context, in *ResolveByViaRequest, opts ...grpc.CallOption) (*ResolveByViaResponse, error)
 }
 
 type contactsClient struct {
@@ -119,6 +121,16 @@ func (c *contactsClient) Locate(ctx context.Context, in *LocateContactRequest, o
 	return out, nil
 }
 
+func (c *contactsClient) ResolveByVia(ctx context.Context, in *ResolveByViaRequest, opts ...grpc.CallOption) (*ResolveByViaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveByViaResponse)
+	err := c.cc.Invoke(ctx, Contacts_ResolveByVia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ContactsServer is the server API for Contacts service.
 // All implementations must embed UnimplementedContactsServer
 // for forward compatibility.
@@ -130,6 +142,7 @@ type ContactsServer interface {
 	Patch(context.Context, *PatchContactRequest) (*Contact, error)
 	Upsert(context.Context, *CreateContactRequest) (*Contact, error)
 	Locate(context.Context, *LocateContactRequest) (*LocateContactResponse, error)
+	ResolveByVia(context.Context, *ResolveByViaRequest) (*ResolveByViaResponse, error)
 	mustEmbedUnimplementedContactsServer()
 }
 
@@ -161,6 +174,9 @@ func (UnimplementedContactsServer) Upsert(context.Context, *CreateContactRequest
 func (UnimplementedContactsServer) Locate(context.Context, *LocateContactRequest) (*LocateContactResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Locate not implemented")
 }
+func (UnimplementedContactsServer) ResolveByVia(context.Context, *ResolveByViaRequest) (*ResolveByViaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveByVia not implemented")
+}
 func (UnimplementedContactsServer) mustEmbedUnimplementedContactsServer() {}
 func (UnimplementedContactsServer) testEmbeddedByValue()                  {}
 
@@ -308,6 +324,24 @@ func _Contacts_Locate_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Contacts_ResolveByVia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveByViaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactsServer).ResolveByVia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Contacts_ResolveByVia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactsServer).ResolveByVia(ctx, req.(*ResolveByViaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Contacts_ServiceDesc is the grpc.ServiceDesc for Contacts service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +377,10 @@ var Contacts_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Locate",
 			Handler:    _Contacts_Locate_Handler,
 		},
+		{
+			MethodName: "ResolveByVia",
+			Handler:    _Contacts_ResolveByVia_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/contact/v1/contact_service.proto",
@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ContactPrivacy_CanSend_FullMethodName   = "/webitel.im.service.contact.v1.ContactPrivacy/CanSend"
-	ContactPrivacy_CanInvite_FullMethodName = "/webitel.im.service.contact.v1.ContactPrivacy/CanInvite"
+	ContactPrivacy_CanSend_FullMethodName        = "/webitel.im.service.contact.v1.ContactPrivacy/CanSend"
+	ContactPrivacy_CanInvite_FullMethodName      = "/webitel.im.service.contact.v1.ContactPrivacy/CanInvite"
+	ContactPrivacy_BatchCanSend_FullMethodName   = "/webitel.im.service.contact.v1.ContactPrivacy/BatchCanSend"
+	ContactPrivacy_BatchCanInvite_FullMethodName = "/webitel.im.service.contact.v1.ContactPrivacy/BatchCanInvite"
 )
 
 // ContactPrivacyClient is the client API for ContactPrivacy service.
@@ -29,6 +31,12 @@ const (
 type ContactPrivacyClient interface {
 	CanSend(ctx context.Context, in *CanSendRequest, opts ...grpc.CallOption) (*CanSendResponse, error)
 	CanInvite(ctx context.Context, in *CanInviteRequest, opts ...grpc.CallOption) (*CanInviteResponse, error)
+	// BatchCanSend checks whether one sender may message every recipient, e.g. on group chat fan-out.
+	BatchCanSend(ctx context.Context, in *BatchCanSendRequest, opts ...grpc.CallOption) (*BatchCanSendResponse, error)
+	// BatchCanInvite checks whether one sender may invite every recipient.
+	// Every distinct recipient takes one token of the sender invite limit. A batch with more
+	// distinct recipients than the limit allows per window fails with INVALID_ARGUMENT.
+	BatchCanInvite(ctx context.Context, in *BatchCanInviteRequest, opts ...grpc.CallOption) (*BatchCanInviteResponse, error)
 }
 
 type contactPrivacyClient struct {
@@ -59,12 +67,38 @@ func (c *contactPrivacyClient) CanInvite(ctx context.Context, in *CanInviteReque
 	return out, nil
 }
 
+func (c *contactPrivacyClient) BatchCanSend(ctx context.Context, in *BatchCanSendRequest, opts ...grpc.CallOption) (*BatchCanSendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCanSendResponse)
+	err := c.cc.Invoke(ctx, ContactPrivacy_BatchCanSend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *contactPrivacyClient) BatchCanInvite(ctx context.Context, in *BatchCanInviteRequest, opts ...grpc.CallOption) (*BatchCanInviteResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchCanInviteResponse)
+	err := c.cc.Invoke(ctx, ContactPrivacy_BatchCanInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ContactPrivacyServer is the server API for ContactPrivacy service.
 // All implementations must embed UnimplementedContactPrivacyServer
 // for forward compatibility.
 type ContactPrivacyServer interface {
 	CanSend(context.Context, *CanSendRequest) (*CanSendResponse, error)
 	CanInvite(context.Context, *CanInviteRequest) (*CanInviteResponse, error)
+	// BatchCanSend checks whether one sender may message every recipient, e.g. on group chat fan-out.
+	BatchCanSend(context.Context, *BatchCanSendRequest) (*BatchCanSendResponse, error)
+	// BatchCanInvite checks whether one sender may invite every recipient.
+	// Every distinct recipient takes one token of the sender invite limit. A batch with more
+	// distinct recipients than the limit allows per window fails with INVALID_ARGUMENT.
+	BatchCanInvite(context.Context, *BatchCanInviteRequest) (*BatchCanInviteResponse, error)
 	mustEmbedUnimplementedContactPrivacyServer()
 }
 
@@ -81,6 +115,12 @@ func (UnimplementedContactPrivacyServer) CanSend(context.Context, *CanSendReques
 func (UnimplementedContactPrivacyServer) CanInvite(context.Context, *CanInviteRequest) (*CanInviteResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CanInvite not implemented")
 }
+func (UnimplementedContactPrivacyServer) BatchCanSend(context.Context, *BatchCanSendRequest) (*BatchCanSendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCanSend not implemented")
+}
+func (UnimplementedContactPrivacyServer) BatchCanInvite(context.Context, *BatchCanInviteRequest) (*BatchCanInviteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCanInvite not implemented")
+}
 func (UnimplementedContactPrivacyServer) mustEmbedUnimplementedContactPrivacyServer() {}
 func (UnimplementedContactPrivacyServer) testEmbeddedByValue()                        {}
 
@@ -138,6 +178,42 @@ func _ContactPrivacy_CanInvite_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ContactPrivacy_BatchCanSend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCanSendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactPrivacyServer).BatchCanSend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactPrivacy_BatchCanSend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactPrivacyServer).BatchCanSend(ctx, req.(*BatchCanSendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ContactPrivacy_BatchCanInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCanInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ContactPrivacyServer).BatchCanInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ContactPrivacy_BatchCanInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ContactPrivacyServer).BatchCanInvite(ctx, req.(*BatchCanInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ContactPrivacy_ServiceDesc is the grpc.ServiceDesc for ContactPrivacy service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +229,14 @@ var ContactPrivacy_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CanInvite",
 			Handler:    _ContactPrivacy_CanInvite_Handler,
 		},
+		{
+			MethodName: "BatchCanSend",
+			Handler:    _ContactPrivacy_BatchCanSend_Handler,
+		},
+		{
+			MethodName: "BatchCanInvite",
+			Handler:    _ContactPrivacy_BatchCanInvite_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/contact/v1/privacy.proto",
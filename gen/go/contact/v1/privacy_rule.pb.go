@@ -0,0 +1,667 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: service/contact/v1/privacy_rule.proto
+
+package contact
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// PrivacyRuleScope selects the privacy checks a rule applies to.
+type PrivacyRuleScope int32
+
+const (
+	PrivacyRuleScope_PRIVACY_RULE_SCOPE_ALL    PrivacyRuleScope = 0
+	PrivacyRuleScope_PRIVACY_RULE_SCOPE_SEND   PrivacyRuleScope = 1
+	PrivacyRuleScope_PRIVACY_RULE_SCOPE_INVITE PrivacyRuleScope = 2
+)
+
+// Enum value maps for PrivacyRuleScope.
+var (
+	PrivacyRuleScope_name = map[int32]string{
+		0: "PRIVACY_RULE_SCOPE_ALL",
+		1: "PRIVACY_RULE_SCOPE_SEND",
+		2: "PRIVACY_RULE_SCOPE_INVITE",
+	}
+	PrivacyRuleScope_value = map[string]int32{
+		"PRIVACY_RULE_SCOPE_ALL":    0,
+		"PRIVACY_RULE_SCOPE_SEND":   1,
+		"PRIVACY_RULE_SCOPE_INVITE": 2,
+	}
+)
+
+func (x PrivacyRuleScope) Enum() *PrivacyRuleScope {
+	p := new(PrivacyRuleScope)
+	*p = x
+	return p
+}
+
+func (x PrivacyRuleScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PrivacyRuleScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_contact_v1_privacy_rule_proto_enumTypes[0].Descriptor()
+}
+
+func (PrivacyRuleScope) Type() protoreflect.EnumType {
+	return &file_service_contact_v1_privacy_rule_proto_enumTypes[0]
+}
+
+func (x PrivacyRuleScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PrivacyRuleScope.Descriptor instead.
+func (PrivacyRuleScope) EnumDescriptor() ([]byte, []int) {
+	return file_service_contact_v1_privacy_rule_proto_rawDescGZIP(), []int{0}
+}
+
+// PrivacyRule is a domain CEL expression evaluated on privacy checks.
+// The expression sees `from` and `to` contacts and the receiver `settings`
+// and must return true to allow the request, e.g.
+// `from.type != "customer" || (to.type == "agent" && from.application_id == to.application_id)`.
+type PrivacyRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DomainId   int32            `protobuf:"varint,2,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Name       string           `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Expression string           `protobuf:"bytes,4,opt,name=expression,proto3" json:"expression,omitempty"`
+	Scope      PrivacyRuleScope `protobuf:"varint,5,opt,name=scope,proto3,enum=webitel.im.service.contact.v1.PrivacyRuleScope" json:"scope,omitempty"`
+	CreatedAt  int64            `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *PrivacyRule) Reset() {
+	*x = PrivacyRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivacyRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyRule) ProtoMessage() {}
+
+func (x *PrivacyRule) ProtoReflect() protoreflect.Message {
+	mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacyRule.ProtoReflect.Descriptor instead.
+func (*PrivacyRule) Descriptor() ([]byte, []int) {
+	return file_service_contact_v1_privacy_rule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PrivacyRule) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PrivacyRule) GetDomainId() int32 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *PrivacyRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PrivacyRule) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *PrivacyRule) GetScope() PrivacyRuleScope {
+	if x != nil {
+		return x.Scope
+	}
+	return PrivacyRuleScope_PRIVACY_RULE_SCOPE_ALL
+}
+
+func (x *PrivacyRule) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type CreatePrivacyRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 means the caller domain; callers bound to a domain may only pass their own.
+	DomainId   int32            `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Name       string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Expression string           `protobuf:"bytes,3,opt,name=expression,proto3" json:"expression,omitempty"`
+	Scope      PrivacyRuleScope `protobuf:"varint,4,opt,name=scope,proto3,enum=webitel.im.service.contact.v1.PrivacyRuleScope" json:"scope,omitempty"`
+}
+
+func (x *CreatePrivacyRuleRequest) Reset() {
+	*x = CreatePrivacyRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePrivacyRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePrivacyRuleRequest) ProtoMessage() {}
+
+func (x *CreatePrivacyRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePrivacyRuleRequest.ProtoReflect.Descriptor instead.
+func (*CreatePrivacyRuleRequest) Descriptor() ([]byte, []int) {
+	return file_service_contact_v1_privacy_rule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePrivacyRuleRequest) GetDomainId() int32 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *CreatePrivacyRuleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreatePrivacyRuleRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *CreatePrivacyRuleRequest) GetScope() PrivacyRuleScope {
+	if x != nil {
+		return x.Scope
+	}
+	return PrivacyRuleScope_PRIVACY_RULE_SCOPE_ALL
+}
+
+type ListPrivacyRulesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 means the caller domain; callers bound to a domain may only pass their own.
+	DomainId int32 `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+}
+
+func (x *ListPrivacyRulesRequest) Reset() {
+	*x = ListPrivacyRulesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPrivacyRulesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPrivacyRulesRequest) ProtoMessage() {}
+
+func (x *ListPrivacyRulesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPrivacyRulesRequest.ProtoReflect.Descriptor instead.
+func (*ListPrivacyRulesRequest) Descriptor() ([]byte, []int) {
+	return file_service_contact_v1_privacy_rule_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListPrivacyRulesRequest) GetDomainId() int32 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+type PrivacyRuleList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*PrivacyRule `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *PrivacyRuleList) Reset() {
+	*x = PrivacyRuleList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrivacyRuleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrivacyRuleList) ProtoMessage() {}
+
+func (x *PrivacyRuleList) ProtoReflect() protoreflect.Message {
+	mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrivacyRuleList.ProtoReflect.Descriptor instead.
+func (*PrivacyRuleList) Descriptor() ([]byte, []int) {
+	return file_service_contact_v1_privacy_rule_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PrivacyRuleList) GetItems() []*PrivacyRule {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeletePrivacyRuleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 means the caller domain; callers bound to a domain may only pass their own.
+	DomainId int32  `protobuf:"varint,1,opt,name=domain_id,json=domainId,proto3" json:"domain_id,omitempty"`
+	Id       string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeletePrivacyRuleRequest) Reset() {
+	*x = DeletePrivacyRuleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePrivacyRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePrivacyRuleRequest) ProtoMessage() {}
+
+func (x *DeletePrivacyRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePrivacyRuleRequest.ProtoReflect.Descriptor instead.
+func (*DeletePrivacyRuleRequest) Descriptor() ([]byte, []int) {
+	return file_service_contact_v1_privacy_rule_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeletePrivacyRuleRequest) GetDomainId() int32 {
+	if x != nil {
+		return x.DomainId
+	}
+	return 0
+}
+
+func (x *DeletePrivacyRuleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type DeletePrivacyRuleResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeletePrivacyRuleResponse) Reset() {
+	*x = DeletePrivacyRuleResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePrivacyRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePrivacyRuleResponse) ProtoMessage() {}
+
+func (x *DeletePrivacyRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_contact_v1_privacy_rule_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePrivacyRuleResponse.ProtoReflect.Descriptor instead.
+func (*DeletePrivacyRuleResponse) Descriptor() ([]byte, []int) {
+	return file_service_contact_v1_privacy_rule_proto_rawDescGZIP(), []int{5}
+}
+
+var File_service_contact_v1_privacy_rule_proto protoreflect.FileDescriptor
+
+var file_service_contact_v1_privacy_rule_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x5f, 0x72, 0x75, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xd4, 0x01, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x45, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0xdd, 0x01, 0x0a, 0x18, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a,
+	0x02, 0x28, 0x00, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a,
+	0x0a, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x20, 0x52, 0x0a, 0x65,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x4f, 0x0a, 0x05, 0x73, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79,
+	0x52, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x42, 0x08, 0xba, 0x48, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x22, 0x3f, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42, 0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28,
+	0x00, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x53, 0x0a, 0x0f, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x40,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x22, 0x5a, 0x0a, 0x18, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x09,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x42,
+	0x07, 0xba, 0x48, 0x04, 0x1a, 0x02, 0x28, 0x00, 0x52, 0x08, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08,
+	0xba, 0x48, 0x05, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a, 0x19,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x6a, 0x0a, 0x10, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x12, 0x1a, 0x0a,
+	0x16, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43, 0x59, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x43,
+	0x4f, 0x50, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x50, 0x52, 0x49,
+	0x56, 0x41, 0x43, 0x59, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f,
+	0x53, 0x45, 0x4e, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x50, 0x52, 0x49, 0x56, 0x41, 0x43,
+	0x59, 0x5f, 0x52, 0x55, 0x4c, 0x45, 0x5f, 0x53, 0x43, 0x4f, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x56,
+	0x49, 0x54, 0x45, 0x10, 0x02, 0x32, 0xea, 0x02, 0x0a, 0x0c, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x12, 0x6d, 0x0a, 0x06, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x12, 0x37, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x77, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x6e, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x2e,
+	0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x7b, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x37, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x42, 0x86, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74,
+	0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x10, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63,
+	0x79, 0x52, 0x75, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x69,
+	0x6d, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0xa2, 0x02, 0x04, 0x57, 0x49, 0x53, 0x43, 0xaa, 0x02, 0x1d, 0x57, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1d, 0x57, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x29, 0x57, 0x65,
+	0x62, 0x69, 0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x5c, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x21, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x3a, 0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x3a,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_service_contact_v1_privacy_rule_proto_rawDescOnce sync.Once
+	file_service_contact_v1_privacy_rule_proto_rawDescData = file_service_contact_v1_privacy_rule_proto_rawDesc
+)
+
+func file_service_contact_v1_privacy_rule_proto_rawDescGZIP() []byte {
+	file_service_contact_v1_privacy_rule_proto_rawDescOnce.Do(func() {
+		file_service_contact_v1_privacy_rule_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_contact_v1_privacy_rule_proto_rawDescData)
+	})
+	return file_service_contact_v1_privacy_rule_proto_rawDescData
+}
+
+var file_service_contact_v1_privacy_rule_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_service_contact_v1_privacy_rule_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_service_contact_v1_privacy_rule_proto_goTypes = []interface{}{
+	(PrivacyRuleScope)(0),             // 0: webitel.im.service.contact.v1.PrivacyRuleScope
+	(*PrivacyRule)(nil),               // 1: webitel.im.service.contact.v1.PrivacyRule
+	(*CreatePrivacyRuleRequest)(nil),  // 2: webitel.im.service.contact.v1.CreatePrivacyRuleRequest
+	(*ListPrivacyRulesRequest)(nil),   // 3: webitel.im.service.contact.v1.ListPrivacyRulesRequest
+	(*PrivacyRuleList)(nil),           // 4: webitel.im.service.contact.v1.PrivacyRuleList
+	(*DeletePrivacyRuleRequest)(nil),  // 5: webitel.im.service.contact.v1.DeletePrivacyRuleRequest
+	(*DeletePrivacyRuleResponse)(nil), // 6: webitel.im.service.contact.v1.DeletePrivacyRuleResponse
+}
+var file_service_contact_v1_privacy_rule_proto_depIdxs = []int32{
+	0, // 0: webitel.im.service.contact.v1.PrivacyRule.scope:type_name -> webitel.im.service.contact.v1.PrivacyRuleScope
+	0, // 1: webitel.im.service.contact.v1.CreatePrivacyRuleRequest.scope:type_name -> webitel.im.service.contact.v1.PrivacyRuleScope
+	1, // 2: webitel.im.service.contact.v1.PrivacyRuleList.items:type_name -> webitel.im.service.contact.v1.PrivacyRule
+	2, // 3: webitel.im.service.contact.v1.PrivacyRules.Create:input_type -> webitel.im.service.contact.v1.CreatePrivacyRuleRequest
+	3, // 4: webitel.im.service.contact.v1.PrivacyRules.List:input_type -> webitel.im.service.contact.v1.ListPrivacyRulesRequest
+	5, // 5: webitel.im.service.contact.v1.PrivacyRules.Delete:input_type -> webitel.im.service.contact.v1.DeletePrivacyRuleRequest
+	1, // 6: webitel.im.service.contact.v1.PrivacyRules.Create:output_type -> webitel.im.service.contact.v1.PrivacyRule
+	4, // 7: webitel.im.service.contact.v1.PrivacyRules.List:output_type -> webitel.im.service.contact.v1.PrivacyRuleList
+	6, // 8: webitel.im.service.contact.v1.PrivacyRules.Delete:output_type -> webitel.im.service.contact.v1.DeletePrivacyRuleResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_service_contact_v1_privacy_rule_proto_init() }
+func file_service_contact_v1_privacy_rule_proto_init() {
+	if File_service_contact_v1_privacy_rule_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_service_contact_v1_privacy_rule_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivacyRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_contact_v1_privacy_rule_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePrivacyRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_contact_v1_privacy_rule_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPrivacyRulesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_contact_v1_privacy_rule_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrivacyRuleList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_contact_v1_privacy_rule_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePrivacyRuleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_contact_v1_privacy_rule_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePrivacyRuleResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_contact_v1_privacy_rule_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_contact_v1_privacy_rule_proto_goTypes,
+		DependencyIndexes: file_service_contact_v1_privacy_rule_proto_depIdxs,
+		EnumInfos:         file_service_contact_v1_privacy_rule_proto_enumTypes,
+		MessageInfos:      file_service_contact_v1_privacy_rule_proto_msgTypes,
+	}.Build()
+	File_service_contact_v1_privacy_rule_proto = out.File
+	file_service_contact_v1_privacy_rule_proto_rawDesc = nil
+	file_service_contact_v1_privacy_rule_proto_goTypes = nil
+	file_service_contact_v1_privacy_rule_proto_depIdxs = nil
+}
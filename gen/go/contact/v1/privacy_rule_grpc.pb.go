@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/contact/v1/privacy_rule.proto
+
+package contact
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	PrivacyRules_Create_FullMethodName = "/webitel.im.service.contact.v1.PrivacyRules/Create"
+	PrivacyRules_List_FullMethodName   = "/webitel.im.service.contact.v1.PrivacyRules/List"
+	PrivacyRules_Delete_FullMethodName = "/webitel.im.service.contact.v1.PrivacyRules/Delete"
+)
+
+// PrivacyRulesClient is the client API for PrivacyRules service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PrivacyRules manages per-domain custom privacy rules. Intended for domain admins.
+type PrivacyRulesClient interface {
+	// Create compiles and registers a rule. Invalid expressions are rejected.
+	Create(ctx context.Context, in *CreatePrivacyRuleRequest, opts ...grpc.CallOption) (*PrivacyRule, error)
+	List(ctx context.Context, in *ListPrivacyRulesRequest, opts ...grpc.CallOption) (*PrivacyRuleList, error)
+	Delete(ctx context.Context, in *DeletePrivacyRuleRequest, opts ...grpc.CallOption) (*DeletePrivacyRuleResponse, error)
+}
+
+type privacyRulesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPrivacyRulesClient(cc grpc.ClientConnInterface) PrivacyRulesClient {
+	return &privacyRulesClient{cc}
+}
+
+func (c *privacyRulesClient) Create(ctx context.Context, in *CreatePrivacyRuleRequest, opts ...grpc.CallOption) (*PrivacyRule, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivacyRule)
+	err := c.cc.Invoke(ctx, PrivacyRules_Create_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privacyRulesClient) List(ctx context.Context, in *ListPrivacyRulesRequest, opts ...grpc.CallOption) (*PrivacyRuleList, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PrivacyRuleList)
+	err := c.cc.Invoke(ctx, PrivacyRules_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *privacyRulesClient) Delete(ctx context.Context, in *DeletePrivacyRuleRequest, opts ...grpc.CallOption) (*DeletePrivacyRuleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePrivacyRuleResponse)
+	err := c.cc.Invoke(ctx, PrivacyRules_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PrivacyRulesServer is the server API for PrivacyRules service.
+// All implementations must embed UnimplementedPrivacyRulesServer
+// for forward compatibility.
+//
+// PrivacyRules manages per-domain custom privacy rules. Intended for domain admins.
+type PrivacyRulesServer interface {
+	// Create compiles and registers a rule. Invalid expressions are rejected.
+	Create(context.Context, *CreatePrivacyRuleRequest) (*PrivacyRule, error)
+	List(context.Context, *ListPrivacyRulesRequest) (*PrivacyRuleList, error)
+	Delete(context.Context, *DeletePrivacyRuleRequest) (*DeletePrivacyRuleResponse, error)
+	mustEmbedUnimplementedPrivacyRulesServer()
+}
+
+// UnimplementedPrivacyRulesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPrivacyRulesServer struct{}
+
+func (UnimplementedPrivacyRulesServer) Create(context.Context, *CreatePrivacyRuleRequest) (*PrivacyRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedPrivacyRulesServer) List(context.Context, *ListPrivacyRulesRequest) (*PrivacyRuleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedPrivacyRulesServer) Delete(context.Context, *DeletePrivacyRuleRequest) (*DeletePrivacyRuleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedPrivacyRulesServer) mustEmbedUnimplementedPrivacyRulesServer() {}
+func (UnimplementedPrivacyRulesServer) testEmbeddedByValue()                      {}
+
+// UnsafePrivacyRulesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PrivacyRulesServer will
+// result in compilation errors.
+type UnsafePrivacyRulesServer interface {
+	mustEmbedUnimplementedPrivacyRulesServer()
+}
+
+func RegisterPrivacyRulesServer(s grpc.ServiceRegistrar, srv PrivacyRulesServer) {
+	// If the following call pancis, it indicates UnimplementedPrivacyRulesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PrivacyRules_ServiceDesc, srv)
+}
+
+func _PrivacyRules_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePrivacyRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyRulesServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrivacyRules_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyRulesServer).Create(ctx, req.(*CreatePrivacyRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivacyRules_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPrivacyRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyRulesServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrivacyRules_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyRulesServer).List(ctx, req.(*ListPrivacyRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PrivacyRules_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePrivacyRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PrivacyRulesServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PrivacyRules_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PrivacyRulesServer).Delete(ctx, req.(*DeletePrivacyRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PrivacyRules_ServiceDesc is the grpc.ServiceDesc for PrivacyRules service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PrivacyRules_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.service.contact.v1.PrivacyRules",
+	HandlerType: (*PrivacyRulesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _PrivacyRules_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _PrivacyRules_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PrivacyRules_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/contact/v1/privacy_rule.proto",
+}
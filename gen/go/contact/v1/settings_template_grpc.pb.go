@@ -0,0 +1,203 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: service/contact/v1/settings_template.proto
+
+package contact
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	SettingsTemplates_Get_FullMethodName    = "/webitel.im.service.contact.v1.SettingsTemplates/Get"
+	SettingsTemplates_Set_FullMethodName    = "/webitel.im.service.contact.v1.SettingsTemplates/Set"
+	SettingsTemplates_Delete_FullMethodName = "/webitel.im.service.contact.v1.SettingsTemplates/Delete"
+)
+
+// SettingsTemplatesClient is the client API for SettingsTemplates service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// SettingsTemplates manages per-domain default privacy settings. Intended for domain admins.
+type SettingsTemplatesClient interface {
+	Get(ctx context.Context, in *GetSettingsTemplateRequest, opts ...grpc.CallOption) (*SettingsTemplate, error)
+	// Set creates or replaces the domain template. Existing contacts are not changed.
+	Set(ctx context.Context, in *SetSettingsTemplateRequest, opts ...grpc.CallOption) (*SettingsTemplate, error)
+	Delete(ctx context.Context, in *DeleteSettingsTemplateRequest, opts ...grpc.CallOption) (*DeleteSettingsTemplateResponse, error)
+}
+
+type settingsTemplatesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSettingsTemplatesClient(cc grpc.ClientConnInterface) SettingsTemplatesClient {
+	return &settingsTemplatesClient{cc}
+}
+
+func (c *settingsTemplatesClient) Get(ctx context.Context, in *GetSettingsTemplateRequest, opts ...grpc.CallOption) (*SettingsTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettingsTemplate)
+	err := c.cc.Invoke(ctx, SettingsTemplates_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsTemplatesClient) Set(ctx context.Context, in *SetSettingsTemplateRequest, opts ...grpc.CallOption) (*SettingsTemplate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettingsTemplate)
+	err := c.cc.Invoke(ctx, SettingsTemplates_Set_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *settingsTemplatesClient) Delete(ctx context.Context, in *DeleteSettingsTemplateRequest, opts ...grpc.CallOption) (*DeleteSettingsTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteSettingsTemplateResponse)
+	err := c.cc.Invoke(ctx, SettingsTemplates_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SettingsTemplatesServer is the server API for SettingsTemplates service.
+// All implementations must embed UnimplementedSettingsTemplatesServer
+// for forward compatibility.
+//
+// SettingsTemplates manages per-domain default privacy settings. Intended for domain admins.
+type SettingsTemplatesServer interface {
+	Get(context.Context, *GetSettingsTemplateRequest) (*SettingsTemplate, error)
+	// Set creates or replaces the domain template. Existing contacts are not changed.
+	Set(context.Context, *SetSettingsTemplateRequest) (*SettingsTemplate, error)
+	Delete(context.Context, *DeleteSettingsTemplateRequest) (*DeleteSettingsTemplateResponse, error)
+	mustEmbedUnimplementedSettingsTemplatesServer()
+}
+
+// UnimplementedSettingsTemplatesServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSettingsTemplatesServer struct{}
+
+func (UnimplementedSettingsTemplatesServer) Get(context.Context, *GetSettingsTemplateRequest) (*SettingsTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedSettingsTemplatesServer) Set(context.Context, *SetSettingsTemplateRequest) (*SettingsTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedSettingsTemplatesServer) Delete(context.Context, *DeleteSettingsTemplateRequest) (*DeleteSettingsTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedSettingsTemplatesServer) mustEmbedUnimplementedSettingsTemplatesServer() {}
+func (UnimplementedSettingsTemplatesServer) testEmbeddedByValue()                           {}
+
+// UnsafeSettingsTemplatesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SettingsTemplatesServer will
+// result in compilation errors.
+type UnsafeSettingsTemplatesServer interface {
+	mustEmbedUnimplementedSettingsTemplatesServer()
+}
+
+func RegisterSettingsTemplatesServer(s grpc.ServiceRegistrar, srv SettingsTemplatesServer) {
+	// If the following call pancis, it indicates UnimplementedSettingsTemplatesServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&SettingsTemplates_ServiceDesc, srv)
+}
+
+func _SettingsTemplates_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSettingsTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsTemplatesServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingsTemplates_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsTemplatesServer).Get(ctx, req.(*GetSettingsTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingsTemplates_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSettingsTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsTemplatesServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingsTemplates_Set_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsTemplatesServer).Set(ctx, req.(*SetSettingsTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SettingsTemplates_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSettingsTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SettingsTemplatesServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SettingsTemplates_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SettingsTemplatesServer).Delete(ctx, req.(*DeleteSettingsTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SettingsTemplates_ServiceDesc is the grpc.ServiceDesc for SettingsTemplates service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var SettingsTemplates_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webitel.im.service.contact.v1.SettingsTemplates",
+	HandlerType: (*SettingsTemplatesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _SettingsTemplates_Get_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _SettingsTemplates_Set_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _SettingsTemplates_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service/contact/v1/settings_template.proto",
+}
@@ -0,0 +1,634 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.1
+// 	protoc        (unknown)
+// source: service/contact/v1/via_consent.proto
+
+package contact
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConsentStatus int32
+
+const (
+	ConsentStatus_CONSENT_STATUS_UNSPECIFIED ConsentStatus = 0
+	ConsentStatus_CONSENT_STATUS_GRANTED     ConsentStatus = 1
+	ConsentStatus_CONSENT_STATUS_REVOKED     ConsentStatus = 2
+)
+
+// Enum value maps for ConsentStatus.
+var (
+	ConsentStatus_name = map[int32]string{
+		0: "CONSENT_STATUS_UNSPECIFIED",
+		1: "CONSENT_STATUS_GRANTED",
+		2: "CONSENT_STATUS_REVOKED",
+	}
+	ConsentStatus_value = map[string]int32{
+		"CONSENT_STATUS_UNSPECIFIED": 0,
+		"CONSENT_STATUS_GRANTED":     1,
+		"CONSENT_STATUS_REVOKED":     2,
+	}
+)
+
+func (x ConsentStatus) Enum() *ConsentStatus {
+	p := new(ConsentStatus)
+	*p = x
+	return p
+}
+
+func (x ConsentStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsentStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_contact_v1_via_consent_proto_enumTypes[0].Descriptor()
+}
+
+func (ConsentStatus) Type() protoreflect.EnumType {
+	return &file_service_contact_v1_via_consent_proto_enumTypes[0]
+}
+
+func (x ConsentStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsentStatus.Descriptor instead.
+func (ConsentStatus) EnumDescriptor() ([]byte, []int) {
+	return file_service_contact_v1_via_consent_proto_rawDescGZIP(), []int{0}
+}
+
+// ConsentFilter narrows Vias.Search by the current marketing consent of a via.
+type ConsentFilter int32
+
+const (
+	ConsentFilter_CONSENT_FILTER_ANY     ConsentFilter = 0
+	ConsentFilter_CONSENT_FILTER_GRANTED ConsentFilter = 1
+	ConsentFilter_CONSENT_FILTER_REVOKED ConsentFilter = 2
+	// Vias that never had consent recorded.
+	ConsentFilter_CONSENT_FILTER_UNKNOWN ConsentFilter = 3
+)
+
+// Enum value maps for ConsentFilter.
+var (
+	ConsentFilter_name = map[int32]string{
+		0: "CONSENT_FILTER_ANY",
+		1: "CONSENT_FILTER_GRANTED",
+		2: "CONSENT_FILTER_REVOKED",
+		3: "CONSENT_FILTER_UNKNOWN",
+	}
+	ConsentFilter_value = map[string]int32{
+		"CONSENT_FILTER_ANY":     0,
+		"CONSENT_FILTER_GRANTED": 1,
+		"CONSENT_FILTER_REVOKED": 2,
+		"CONSENT_FILTER_UNKNOWN": 3,
+	}
+)
+
+func (x ConsentFilter) Enum() *ConsentFilter {
+	p := new(ConsentFilter)
+	*p = x
+	return p
+}
+
+func (x ConsentFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConsentFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_service_contact_v1_via_consent_proto_enumTypes[1].Descriptor()
+}
+
+func (ConsentFilter) Type() protoreflect.EnumType {
+	return &file_service_contact_v1_via_consent_proto_enumTypes[1]
+}
+
+func (x ConsentFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConsentFilter.Descriptor instead.
+func (ConsentFilter) EnumDescriptor() ([]byte, []int) {
+	return file_service_contact_v1_via_consent_proto_rawDescGZIP(), []int{1}
+}
+
+// ViaConsent is one marketing consent change of a via.
+type ViaConsent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ContactId string        `protobuf:"bytes,2,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Via       string        `protobuf:"bytes,3,opt,name=via,proto3" json:"via,omitempty"`
+	Status    ConsentStatus `protobuf:"varint,4,opt,name=status,proto3,enum=webitel.im.service.contact.v1.ConsentStatus" json:"status,omitempty"`
+	// Where the consent was collected, e.g. "web-form" or "operator".
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	// Legal basis of the processing, e.g. "consent" or "legitimate_interest".
+	LegalBasis string `protobuf:"bytes,6,opt,name=legal_basis,json=legalBasis,proto3" json:"legal_basis,omitempty"`
+	// Unix milliseconds of when the contact gave or withdrew consent.
+	OccurredAt int64 `protobuf:"varint,7,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+	CreatedAt  int64 `protobuf:"varint,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ViaConsent) Reset() {
+	*x = ViaConsent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_contact_v1_via_consent_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ViaConsent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ViaConsent) ProtoMessage() {}
+
+func (x *ViaConsent) ProtoReflect() protoreflect.Message {
+	mi := &file_service_contact_v1_via_consent_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ViaConsent.ProtoReflect.Descriptor instead.
+func (*ViaConsent) Descriptor() ([]byte, []int) {
+	return file_service_contact_v1_via_consent_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ViaConsent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ViaConsent) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *ViaConsent) GetVia() string {
+	if x != nil {
+		return x.Via
+	}
+	return ""
+}
+
+func (x *ViaConsent) GetStatus() ConsentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ConsentStatus_CONSENT_STATUS_UNSPECIFIED
+}
+
+func (x *ViaConsent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ViaConsent) GetLegalBasis() string {
+	if x != nil {
+		return x.LegalBasis
+	}
+	return ""
+}
+
+func (x *ViaConsent) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+func (x *ViaConsent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type ChangeViaConsentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContactId  string `protobuf:"bytes,1,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Via        string `protobuf:"bytes,2,opt,name=via,proto3" json:"via,omitempty"`
+	Source     string `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	LegalBasis string `protobuf:"bytes,4,opt,name=legal_basis,json=legalBasis,proto3" json:"legal_basis,omitempty"`
+	// Unix milliseconds; defaults to the time of the request.
+	OccurredAt int64 `protobuf:"varint,5,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"`
+}
+
+func (x *ChangeViaConsentRequest) Reset() {
+	*x = ChangeViaConsentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_contact_v1_via_consent_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeViaConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeViaConsentRequest) ProtoMessage() {}
+
+func (x *ChangeViaConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_contact_v1_via_consent_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeViaConsentRequest.ProtoReflect.Descriptor instead.
+func (*ChangeViaConsentRequest) Descriptor() ([]byte, []int) {
+	return file_service_contact_v1_via_consent_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ChangeViaConsentRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *ChangeViaConsentRequest) GetVia() string {
+	if x != nil {
+		return x.Via
+	}
+	return ""
+}
+
+func (x *ChangeViaConsentRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ChangeViaConsentRequest) GetLegalBasis() string {
+	if x != nil {
+		return x.LegalBasis
+	}
+	return ""
+}
+
+func (x *ChangeViaConsentRequest) GetOccurredAt() int64 {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return 0
+}
+
+type GetViaConsentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContactId      string `protobuf:"bytes,1,opt,name=contact_id,json=contactId,proto3" json:"contact_id,omitempty"`
+	Via            string `protobuf:"bytes,2,opt,name=via,proto3" json:"via,omitempty"`
+	IncludeHistory bool   `protobuf:"varint,3,opt,name=include_history,json=includeHistory,proto3" json:"include_history,omitempty"`
+}
+
+func (x *GetViaConsentRequest) Reset() {
+	*x = GetViaConsentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_contact_v1_via_consent_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetViaConsentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetViaConsentRequest) ProtoMessage() {}
+
+func (x *GetViaConsentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_service_contact_v1_via_consent_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetViaConsentRequest.ProtoReflect.Descriptor instead.
+func (*GetViaConsentRequest) Descriptor() ([]byte, []int) {
+	return file_service_contact_v1_via_consent_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetViaConsentRequest) GetContactId() string {
+	if x != nil {
+		return x.ContactId
+	}
+	return ""
+}
+
+func (x *GetViaConsentRequest) GetVia() string {
+	if x != nil {
+		return x.Via
+	}
+	return ""
+}
+
+func (x *GetViaConsentRequest) GetIncludeHistory() bool {
+	if x != nil {
+		return x.IncludeHistory
+	}
+	return false
+}
+
+type GetViaConsentResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unset when consent was never recorded for the via.
+	Current *ViaConsent `protobuf:"bytes,1,opt,name=current,proto3" json:"current,omitempty"`
+	// Every change newest first, filled with include_history only.
+	History []*ViaConsent `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *GetViaConsentResponse) Reset() {
+	*x = GetViaConsentResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_service_contact_v1_via_consent_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetViaConsentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetViaConsentResponse) ProtoMessage() {}
+
+func (x *GetViaConsentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_service_contact_v1_via_consent_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetViaConsentResponse.ProtoReflect.Descriptor instead.
+func (*GetViaConsentResponse) Descriptor() ([]byte, []int) {
+	return file_service_contact_v1_via_consent_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetViaConsentResponse) GetCurrent() *ViaConsent {
+	if x != nil {
+		return x.Current
+	}
+	return nil
+}
+
+func (x *GetViaConsentResponse) GetHistory() []*ViaConsent {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+var File_service_contact_v1_via_consent_proto protoreflect.FileDescriptor
+
+var file_service_contact_v1_via_consent_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x76, 0x69, 0x61, 0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e,
+	0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1b, 0x62, 0x75, 0x66, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0x8c, 0x02, 0x0a, 0x0a, 0x56, 0x69, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64,
+	0x12, 0x10, 0x0a, 0x03, 0x76, 0x69, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x76,
+	0x69, 0x61, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x42, 0x61, 0x73, 0x69,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0xda, 0x01, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x56, 0x69, 0x61, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a,
+	0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0b, 0xba, 0x48, 0x08, 0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x03, 0x76, 0x69, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x03, 0x76, 0x69, 0x61, 0x12, 0x22, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x72, 0x05, 0x10, 0x01, 0x18, 0xff, 0x01,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2a, 0x0a, 0x0b, 0x6c, 0x65, 0x67, 0x61,
+	0x6c, 0x5f, 0x62, 0x61, 0x73, 0x69, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09, 0xba,
+	0x48, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x0a, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x42,
+	0x61, 0x73, 0x69, 0x73, 0x12, 0x28, 0x0a, 0x0b, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xba, 0x48, 0x04, 0x22, 0x02,
+	0x28, 0x00, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22, 0x86,
+	0x01, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x56, 0x69, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0b, 0xba, 0x48, 0x08,
+	0xc8, 0x01, 0x01, 0x72, 0x03, 0xb0, 0x01, 0x01, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x03, 0x76, 0x69, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x03, 0x76, 0x69, 0x61, 0x12, 0x27,
+	0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0xa1, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x56,
+	0x69, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x43, 0x0a, 0x07, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2e, 0x69, 0x6d, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x69, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x52, 0x07, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x69, 0x61, 0x43, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x74, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2a, 0x67, 0x0a, 0x0d, 0x43,
+	0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x1a,
+	0x43, 0x4f, 0x4e, 0x53, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16,
+	0x43, 0x4f, 0x4e, 0x53, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x47,
+	0x52, 0x41, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x53,
+	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x56, 0x4f, 0x4b,
+	0x45, 0x44, 0x10, 0x02, 0x2a, 0x7b, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x74, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x4e, 0x54,
+	0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x1a, 0x0a,
+	0x16, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f,
+	0x47, 0x52, 0x41, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e,
+	0x53, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x52, 0x45, 0x56, 0x4f,
+	0x4b, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4e, 0x53, 0x45, 0x4e, 0x54,
+	0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
+	0x03, 0x42, 0x85, 0x02, 0x0a, 0x21, 0x63, 0x6f, 0x6d, 0x2e, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65,
+	0x6c, 0x2e, 0x69, 0x6d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x56, 0x69, 0x61, 0x43, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x36, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x77, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x67, 0x6f, 0x2f, 0x69, 0x6d, 0x2f,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0xa2, 0x02, 0x04, 0x57, 0x49, 0x53, 0x43, 0xaa, 0x02, 0x1d, 0x57, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x2e, 0x49, 0x6d, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1d, 0x57, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x29, 0x57, 0x65, 0x62, 0x69,
+	0x74, 0x65, 0x6c, 0x5c, 0x49, 0x6d, 0x5c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5c, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x21, 0x57, 0x65, 0x62, 0x69, 0x74, 0x65, 0x6c, 0x3a,
+	0x3a, 0x49, 0x6d, 0x3a, 0x3a, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x3a, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_service_contact_v1_via_consent_proto_rawDescOnce sync.Once
+	file_service_contact_v1_via_consent_proto_rawDescData = file_service_contact_v1_via_consent_proto_rawDesc
+)
+
+func file_service_contact_v1_via_consent_proto_rawDescGZIP() []byte {
+	file_service_contact_v1_via_consent_proto_rawDescOnce.Do(func() {
+		file_service_contact_v1_via_consent_proto_rawDescData = protoimpl.X.CompressGZIP(file_service_contact_v1_via_consent_proto_rawDescData)
+	})
+	return file_service_contact_v1_via_consent_proto_rawDescData
+}
+
+var file_service_contact_v1_via_consent_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_service_contact_v1_via_consent_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_service_contact_v1_via_consent_proto_goTypes = []interface{}{
+	(ConsentStatus)(0),              // 0: webitel.im.service.contact.v1.ConsentStatus
+	(ConsentFilter)(0),              // 1: webitel.im.service.contact.v1.ConsentFilter
+	(*ViaConsent)(nil),              // 2: webitel.im.service.contact.v1.ViaConsent
+	(*ChangeViaConsentRequest)(nil), // 3: webitel.im.service.contact.v1.ChangeViaConsentRequest
+	(*GetViaConsentRequest)(nil),    // 4: webitel.im.service.contact.v1.GetViaConsentRequest
+	(*GetViaConsentResponse)(nil),   // 5: webitel.im.service.contact.v1.GetViaConsentResponse
+}
+var file_service_contact_v1_via_consent_proto_depIdxs = []int32{
+	0, // 0: webitel.im.service.contact.v1.ViaConsent.status:type_name -> webitel.im.service.contact.v1.ConsentStatus
+	2, // 1: webitel.im.service.contact.v1.GetViaConsentResponse.current:type_name -> webitel.im.service.contact.v1.ViaConsent
+	2, // 2: webitel.im.service.contact.v1.GetViaConsentResponse.history:type_name -> webitel.im.service.contact.v1.ViaConsent
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_service_contact_v1_via_consent_proto_init() }
+func file_service_contact_v1_via_consent_proto_init() {
+	if File_service_contact_v1_via_consent_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_service_contact_v1_via_consent_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ViaConsent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_contact_v1_via_consent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeViaConsentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_contact_v1_via_consent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetViaConsentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_service_contact_v1_via_consent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetViaConsentResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_service_contact_v1_via_consent_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_service_contact_v1_via_consent_proto_goTypes,
+		DependencyIndexes: file_service_contact_v1_via_consent_proto_depIdxs,
+		EnumInfos:         file_service_contact_v1_via_consent_proto_enumTypes,
+		MessageInfos:      file_service_contact_v1_via_consent_proto_msgTypes,
+	}.Build()
+	File_service_contact_v1_via_consent_proto = out.File
+	file_service_contact_v1_via_consent_proto_rawDesc = nil
+	file_service_contact_v1_via_consent_proto_goTypes = nil
+	file_service_contact_v1_via_consent_proto_depIdxs = nil
+}
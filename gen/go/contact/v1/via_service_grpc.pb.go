@@ -19,10 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Vias_Create_FullMethodName        = "/webitel.im.service.contact.v1.Vias/Create"
-	Vias_Update_FullMethodName        = "/webitel.im.service.contact.v1.Vias/Update"
-	Vias_PartialUpdate_FullMethodName = "/webitel.im.service.contact.v1.Vias/PartialUpdate"
-	Vias_Search_FullMethodName        = "/webitel.im.service.contact.v1.Vias/Search"
+	Vias_Create_FullMethodName              = "/webitel.im.service.contact.v1.Vias/Create"
+	Vias_Update_FullMethodName              = "/webitel.im.service.contact.v1.Vias/Update"
+	Vias_PartialUpdate_FullMethodName       = "/webitel.im.service.contact.v1.Vias/PartialUpdate"
+	Vias_Search_FullMethodName              = "/webitel.im.service.contact.v1.Vias/Search"
+	Vias_Delete_FullMethodName              = "/webitel.im.service.contact.v1.Vias/Delete"
+	Vias_DeleteByContact_FullMethodName     = "/webitel.im.service.contact.v1.Vias/DeleteByContact"
+	Vias_Replace_FullMethodName             = "/webitel.im.service.contact.v1.Vias/Replace"
+	Vias_StartVerification_FullMethodName   = "/webitel.im.service.contact.v1.Vias/StartVerification"
+	Vias_ConfirmVerification_FullMethodName = "/webitel.im.service.contact.v1.Vias/ConfirmVerification"
+	Vias_SetPrimaryVia_FullMethodName       = "/webitel.im.service.contact.v1.Vias/SetPrimaryVia"
+	Vias_GrantConsent_FullMethodName        = "/webitel.im.service.contact.v1.Vias/GrantConsent"
+	Vias_RevokeConsent_FullMethodName       = "/webitel.im.service.contact.v1.Vias/RevokeConsent"
+	Vias_GetConsent_FullMethodName          = "/webitel.im.service.contact.v1.Vias/GetConsent"
 )
 
 // ViasClient is the client API for Vias service.
@@ -33,6 +42,26 @@ type ViasClient interface {
 	Update(ctx context.Context, in *UpdateViaRequest, opts ...grpc.CallOption) (*Via, error)
 	PartialUpdate(ctx context.Context, in *PartialUpdateViaRequest, opts ...grpc.CallOption) (*Via, error)
 	Search(ctx context.Context, in *SearchViaRequest, opts ...grpc.CallOption) (*SearchViaResponse, error)
+	// Delete removes a single via and returns it.
+	Delete(ctx context.Context, in *DeleteViaRequest, opts ...grpc.CallOption) (*Via, error)
+	// DeleteByContact removes every via of the contact.
+	DeleteByContact(ctx context.Context, in *DeleteViasByContactRequest, opts ...grpc.CallOption) (*DeleteViasByContactResponse, error)
+	// Replace makes the contact vias match the given full set in one transaction and returns the resulting list.
+	// Vias missing from the set are deleted; events are published only for actual changes.
+	Replace(ctx context.Context, in *ReplaceViasRequest, opts ...grpc.CallOption) (*ReplaceViasResponse, error)
+	// StartVerification issues a one-time code for the via; the code itself is only delivered via the event bus.
+	// A new code replaces the pending one only after the resend delay; earlier calls fail with RESOURCE_EXHAUSTED.
+	StartVerification(ctx context.Context, in *StartViaVerificationRequest, opts ...grpc.CallOption) (*StartViaVerificationResponse, error)
+	// ConfirmVerification checks the code and marks the via verified.
+	ConfirmVerification(ctx context.Context, in *ConfirmViaVerificationRequest, opts ...grpc.CallOption) (*Via, error)
+	// SetPrimaryVia makes the via the only primary one among the contact vias of its kind.
+	SetPrimaryVia(ctx context.Context, in *SetPrimaryViaRequest, opts ...grpc.CallOption) (*Via, error)
+	// GrantConsent records a marketing opt-in for the via.
+	GrantConsent(ctx context.Context, in *ChangeViaConsentRequest, opts ...grpc.CallOption) (*ViaConsent, error)
+	// RevokeConsent records a marketing opt-out for the via.
+	RevokeConsent(ctx context.Context, in *ChangeViaConsentRequest, opts ...grpc.CallOption) (*ViaConsent, error)
+	// GetConsent returns the current marketing consent of the via.
+	GetConsent(ctx context.Context, in *GetViaConsentRequest, opts ...grpc.CallOption) (*GetViaConsentResponse, error)
 }
 
 type viasClient struct {
@@ -83,6 +112,96 @@ func (c *viasClient) Search(ctx context.Context, in *SearchViaRequest, opts ...g
 	return out, nil
 }
 
+func (c *viasClient) Delete(ctx context.Context, in *DeleteViaRequest, opts ...grpc.CallOption) (*Via, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Via)
+	err := c.cc.Invoke(ctx, Vias_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viasClient) DeleteByContact(ctx context.Context, in *DeleteViasByContactRequest, opts ...grpc.CallOption) (*DeleteViasByContactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteViasByContactResponse)
+	err := c.cc.Invoke(ctx, Vias_DeleteByContact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viasClient) Replace(ctx context.Context, in *ReplaceViasRequest, opts ...grpc.CallOption) (*ReplaceViasResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaceViasResponse)
+	err := c.cc.Invoke(ctx, Vias_Replace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viasClient) StartVerification(ctx context.Context, in *StartViaVerificationRequest, opts ...grpc.CallOption) (*StartViaVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StartViaVerificationResponse)
+	err := c.cc.Invoke(ctx, Vias_StartVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viasClient) ConfirmVerification(ctx context.Context, in *ConfirmViaVerificationRequest, opts ...grpc.CallOption) (*Via, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Via)
+	err := c.cc.Invoke(ctx, Vias_ConfirmVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viasClient) SetPrimaryVia(ctx context.Context, in *SetPrimaryViaRequest, opts ...grpc.CallOption) (*Via, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Via)
+	err := c.cc.Invoke(ctx, Vias_SetPrimaryVia_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viasClient) GrantConsent(ctx context.Context, in *ChangeViaConsentRequest, opts ...grpc.CallOption) (*ViaConsent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ViaConsent)
+	err := c.cc.Invoke(ctx, Vias_GrantConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viasClient) RevokeConsent(ctx context.Context, in *ChangeViaConsentRequest, opts ...grpc.CallOption) (*ViaConsent, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ViaConsent)
+	err := c.cc.Invoke(ctx, Vias_RevokeConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *viasClient) GetConsent(ctx context.Context, in *GetViaConsentRequest, opts ...grpc.CallOption) (*GetViaConsentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetViaConsentResponse)
+	err := c.cc.Invoke(ctx, Vias_GetConsent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ViasServer is the server API for Vias service.
 // All implementations must embed UnimplementedViasServer
 // for forward compatibility.
@@ -91,6 +210,26 @@ type ViasServer interface {
 	Update(context.Context, *UpdateViaRequest) (*Via, error)
 	PartialUpdate(context.Context, *PartialUpdateViaRequest) (*Via, error)
 	Search(context.Context, *SearchViaRequest) (*SearchViaResponse, error)
+	// Delete removes a single via and returns it.
+	Delete(context.Context, *DeleteViaRequest) (*Via, error)
+	// DeleteByContact removes every via of the contact.
+	DeleteByContact(context.Context, *DeleteViasByContactRequest) (*DeleteViasByContactResponse, error)
+	// Replace makes the contact vias match the given full set in one transaction and returns the resulting list.
+	// Vias missing from the set are deleted; events are published only for actual changes.
+	Replace(context.Context, *ReplaceViasRequest) (*ReplaceViasResponse, error)
+	// StartVerification issues a one-time code for the via; the code itself is only delivered via the event bus.
+	// A new code replaces the pending one only after the resend delay; earlier calls fail with RESOURCE_EXHAUSTED.
+	StartVerification(context.Context, *StartViaVerificationRequest) (*StartViaVerificationResponse, error)
+	// ConfirmVerification checks the code and marks the via verified.
+	ConfirmVerification(context.Context, *ConfirmViaVerificationRequest) (*Via, error)
+	// SetPrimaryVia makes the via the only primary one among the contact vias of its kind.
+	SetPrimaryVia(context.Context, *SetPrimaryViaRequest) (*Via, error)
+	// GrantConsent records a marketing opt-in for the via.
+	GrantConsent(context.Context, *ChangeViaConsentRequest) (*ViaConsent, error)
+	// RevokeConsent records a marketing opt-out for the via.
+	RevokeConsent(context.Context, *ChangeViaConsentRequest) (*ViaConsent, error)
+	// GetConsent returns the current marketing consent of the via.
+	GetConsent(context.Context, *GetViaConsentRequest) (*GetViaConsentResponse, error)
 	mustEmbedUnimplementedViasServer()
 }
 
@@ -113,6 +252,33 @@ func (UnimplementedViasServer) PartialUpdate(context.Context, *PartialUpdateViaR
 func (UnimplementedViasServer) Search(context.Context, *SearchViaRequest) (*SearchViaResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedViasServer) Delete(context.Context, *DeleteViaRequest) (*Via, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedViasServer) DeleteByContact(context.Context, *DeleteViasByContactRequest) (*DeleteViasByContactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteByContact not implemented")
+}
+func (UnimplementedViasServer) Replace(context.Context, *ReplaceViasRequest) (*ReplaceViasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Replace not implemented")
+}
+func (UnimplementedViasServer) StartVerification(context.Context, *StartViaVerificationRequest) (*StartViaVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartVerification not implemented")
+}
+func (UnimplementedViasServer) ConfirmVerification(context.Context, *ConfirmViaVerificationRequest) (*Via, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmVerification not implemented")
+}
+func (UnimplementedViasServer) SetPrimaryVia(context.Context, *SetPrimaryViaRequest) (*Via, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPrimaryVia not implemented")
+}
+func (UnimplementedViasServer) GrantConsent(context.Context, *ChangeViaConsentRequest) (*ViaConsent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantConsent not implemented")
+}
+func (UnimplementedViasServer) RevokeConsent(context.Context, *ChangeViaConsentRequest) (*ViaConsent, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeConsent not implemented")
+}
+func (UnimplementedViasServer) GetConsent(context.Context, *GetViaConsentRequest) (*GetViaConsentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsent not implemented")
+}
 func (UnimplementedViasServer) mustEmbedUnimplementedViasServer() {}
 func (UnimplementedViasServer) testEmbeddedByValue()              {}
 
@@ -206,6 +372,168 @@ func _Vias_Search_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Vias_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteViaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViasServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vias_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViasServer).Delete(ctx, req.(*DeleteViaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vias_DeleteByContact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteViasByContactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViasServer).DeleteByContact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vias_DeleteByContact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViasServer).DeleteByContact(ctx, req.(*DeleteViasByContactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vias_Replace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceViasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViasServer).Replace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vias_Replace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViasServer).Replace(ctx, req.(*ReplaceViasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vias_StartVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartViaVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViasServer).StartVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vias_StartVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViasServer).StartVerification(ctx, req.(*StartViaVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vias_ConfirmVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmViaVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViasServer).ConfirmVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vias_ConfirmVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViasServer).ConfirmVerification(ctx, req.(*ConfirmViaVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vias_SetPrimaryVia_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPrimaryViaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViasServer).SetPrimaryVia(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vias_SetPrimaryVia_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViasServer).SetPrimaryVia(ctx, req.(*SetPrimaryViaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vias_GrantConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeViaConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViasServer).GrantConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vias_GrantConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViasServer).GrantConsent(ctx, req.(*ChangeViaConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vias_RevokeConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeViaConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViasServer).RevokeConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vias_RevokeConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViasServer).RevokeConsent(ctx, req.(*ChangeViaConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Vias_GetConsent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetViaConsentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ViasServer).GetConsent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Vias_GetConsent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ViasServer).GetConsent(ctx, req.(*GetViaConsentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Vias_ServiceDesc is the grpc.ServiceDesc for Vias service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +557,42 @@ var Vias_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _Vias_Search_Handler,
 		},
+		{
+			MethodName: "Delete",
+			Handler:    _Vias_Delete_Handler,
+		},
+		{
+			MethodName: "DeleteByContact",
+			Handler:    _Vias_DeleteByContact_Handler,
+		},
+		{
+			MethodName: "Replace",
+			Handler:    _Vias_Replace_Handler,
+		},
+		{
+			MethodName: "StartVerification",
+			Handler:    _Vias_StartVerification_Handler,
+		},
+		{
+			MethodName: "ConfirmVerification",
+			Handler:    _Vias_ConfirmVerification_Handler,
+		},
+		{
+			MethodName: "SetPrimaryVia",
+			Handler:    _Vias_SetPrimaryVia_Handler,
+		},
+		{
+			MethodName: "GrantConsent",
+			Handler:    _Vias_GrantConsent_Handler,
+		},
+		{
+			MethodName: "RevokeConsent",
+			Handler:    _Vias_RevokeConsent_Handler,
+		},
+		{
+			MethodName: "GetConsent",
+			Handler:    _Vias_GetConsent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service/contact/v1/via_service.proto",
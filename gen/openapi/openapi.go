@@ -0,0 +1,7 @@
+// Package openapi embeds the OpenAPI document of the HTTP/JSON gateway generated from the contact protos.
+package openapi
+
+import _ "embed"
+
+//go:embed contact.swagger.json
+var Contact []byte
@@ -0,0 +1,44 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Identity is the authenticated caller of a request.
+type Identity struct {
+	// DomainID is the domain the caller acts in. It is 0 only for a service
+	// trusted by its certificate that didn't name a domain, such a caller may act in any domain.
+	DomainID int
+	UserID   int
+	// ContactID is set when the caller acts as a contact; such a caller may change only its own data.
+	ContactID uuid.UUID
+	Subject   string
+	// Service is set for callers authenticated by an mTLS client certificate.
+	Service bool
+}
+
+// CrossDomain reports whether the caller isn't bound to a single domain.
+func (i *Identity) CrossDomain() bool {
+	return i.DomainID == 0
+}
+
+// IsContact reports whether the caller acts as a contact.
+func (i *Identity) IsContact() bool {
+	return i.ContactID != uuid.Nil
+}
+
+type identityKey struct{}
+
+// NewContext returns a copy of ctx carrying the caller identity.
+func NewContext(ctx context.Context, identity *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+// FromContext returns the caller identity stored by [NewContext].
+func FromContext(ctx context.Context) (*Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(*Identity)
+
+	return identity, ok && identity != nil
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func signToken(t *testing.T, secret string, claims map[string]any) string {
+	t.Helper()
+
+	encode := func(v any) string {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return base64.RawURLEncoding.EncodeToString(raw)
+	}
+
+	unsigned := encode(map[string]string{"alg": "HS256", "typ": "JWT"}) + "." + encode(claims)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestTokenVerifierVerify(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+
+	v := NewTokenVerifier("secret")
+	v.now = func() time.Time { return now }
+
+	valid := map[string]any{
+		"sub":        "user-1",
+		"dc":         7,
+		"uid":        10,
+		"contact_id": "550e8400-e29b-41d4-a716-446655440000",
+		"exp":        now.Add(time.Hour).Unix(),
+	}
+
+	identity, err := v.Verify(signToken(t, "secret", valid))
+	if err != nil {
+		t.Fatalf("verify valid token: %v", err)
+	}
+
+	if identity.DomainID != 7 || identity.UserID != 10 || !identity.IsContact() || identity.Service {
+		t.Fatalf("unexpected identity %+v", identity)
+	}
+
+	expired := map[string]any{"dc": 7, "exp": now.Add(-time.Hour).Unix()}
+	noDomain := map[string]any{"exp": now.Add(time.Hour).Unix()}
+
+	for name, token := range map[string]string{
+		"wrong secret": signToken(t, "other", valid),
+		"expired":      signToken(t, "secret", expired),
+		"no domain":    signToken(t, "secret", noDomain),
+		"malformed":    "not-a-token",
+	} {
+		if _, err := v.Verify(token); err == nil {
+			t.Errorf("%s: expected token to be rejected", name)
+		}
+	}
+
+	if _, err := NewTokenVerifier("").Verify(signToken(t, "", valid)); err == nil {
+		t.Error("expected tokens to be rejected without a secret")
+	}
+}
@@ -0,0 +1,52 @@
+package cache
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/webitel/im-contact-service/config"
+	"github.com/webitel/im-contact-service/infra/cache/redis"
+)
+
+// Cache is a byte-oriented key-value store with per-key expiration.
+type Cache interface {
+	// Get returns the cached value and true, or false when the key is missing or expired.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// MGet returns cached values for the keys in the same order; missing keys are nil.
+	MGet(ctx context.Context, keys ...string) ([][]byte, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
+
+var Module = fx.Module("cache",
+	fx.Provide(
+		ProvideCache,
+	),
+)
+
+func ProvideCache(cfg *config.Config, l *slog.Logger, lc fx.Lifecycle) (Cache, error) {
+	c := redis.New(redis.Config{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			// the cache is an optimization, so the service keeps working against the database without it
+			if err := c.Ping(ctx); err != nil {
+				l.Warn("[CACHE] redis is unreachable", "error", err)
+			}
+
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			return c.Close()
+		},
+	})
+
+	return c, nil
+}
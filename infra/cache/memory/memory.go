@@ -0,0 +1,88 @@
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache is an in-process implementation of the cache contract, intended for tests
+// and single-instance setups. Expired entries are dropped lazily on access.
+type Cache struct {
+	mu      sync.Mutex
+	entries map[string]entry
+	now     func() time.Time
+}
+
+type entry struct {
+	value     []byte
+	expiresAt time.Time
+}
+
+func New() *Cache {
+	return &Cache{
+		entries: make(map[string]entry),
+		now:     time.Now,
+	}
+}
+
+func (c *Cache) Get(_ context.Context, key string) ([]byte, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	value, ok := c.get(key)
+
+	return value, ok, nil
+}
+
+func (c *Cache) MGet(_ context.Context, keys ...string) ([][]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	result := make([][]byte, len(keys))
+	for i, key := range keys {
+		result[i], _ = c.get(key)
+	}
+
+	return result, nil
+}
+
+func (c *Cache) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := entry{value: append([]byte(nil), value...)}
+	if ttl > 0 {
+		e.expiresAt = c.now().Add(ttl)
+	}
+
+	c.entries[key] = e
+
+	return nil
+}
+
+func (c *Cache) Delete(_ context.Context, keys ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+
+	return nil
+}
+
+func (c *Cache) get(key string) ([]byte, bool) {
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	if !e.expiresAt.IsZero() && !c.now().Before(e.expiresAt) {
+		delete(c.entries, key)
+
+		return nil, false
+	}
+
+	return append([]byte(nil), e.value...), true
+}
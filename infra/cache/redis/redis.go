@@ -0,0 +1,91 @@
+package redis
+
+import (
+	"context"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+type Config struct {
+	Addr     string
+	Password string
+	DB       int
+}
+
+type Cache struct {
+	client *goredis.Client
+}
+
+func New(conf Config) *Cache {
+	return &Cache{
+		client: goredis.NewClient(&goredis.Options{
+			Addr:     conf.Addr,
+			Password: conf.Password,
+			DB:       conf.DB,
+		}),
+	}
+}
+
+func (c *Cache) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := c.client.Get(ctx, key).Bytes()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, false, nil
+		}
+
+		return nil, false, errors.Internal("getting cached value", errors.WithCause(err), errors.WithID("cache.redis.get"))
+	}
+
+	return value, true, nil
+}
+
+func (c *Cache) MGet(ctx context.Context, keys ...string) ([][]byte, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	values, err := c.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, errors.Internal("getting cached values", errors.WithCause(err), errors.WithID("cache.redis.mget"))
+	}
+
+	result := make([][]byte, len(values))
+	for i, value := range values {
+		if s, ok := value.(string); ok {
+			result[i] = []byte(s)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *Cache) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	if err := c.client.Set(ctx, key, value, ttl).Err(); err != nil {
+		return errors.Internal("setting cached value", errors.WithCause(err), errors.WithID("cache.redis.set"))
+	}
+
+	return nil
+}
+
+func (c *Cache) Delete(ctx context.Context, keys ...string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	if err := c.client.Del(ctx, keys...).Err(); err != nil {
+		return errors.Internal("deleting cached values", errors.WithCause(err), errors.WithID("cache.redis.delete"))
+	}
+
+	return nil
+}
+
+func (c *Cache) Ping(ctx context.Context) error {
+	return c.client.Ping(ctx).Err()
+}
+
+func (c *Cache) Close() error {
+	return c.client.Close()
+}
@@ -0,0 +1,18 @@
+package pg
+
+import "context"
+
+type forceMasterKey struct{}
+
+// WithMaster marks the context so that read queries are served by master.
+// Use it when a request has to observe its own just committed writes.
+func WithMaster(ctx context.Context) context.Context {
+	return context.WithValue(ctx, forceMasterKey{}, true)
+}
+
+// IsMasterForced reports whether the context was marked with [WithMaster].
+func IsMasterForced(ctx context.Context) bool {
+	forced, _ := ctx.Value(forceMasterKey{}).(bool)
+
+	return forced
+}
@@ -3,6 +3,8 @@ package pg
 import (
 	"context"
 	"log/slog"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/exaring/otelpgx"
@@ -11,23 +13,95 @@ import (
 	"github.com/webitel/webitel-go-kit/pkg/errors"
 )
 
+const defaultReplicaCheckInterval = 5 * time.Second
+
 type PgxDB struct {
-	master *pgxpool.Pool
-	logger *slog.Logger
+	master   *pgxpool.Pool
+	replicas []*replica
+	next     atomic.Uint64
+	logger   *slog.Logger
+
+	stop chan struct{}
+	wg   sync.WaitGroup
 }
 
 type ConnectionConfig struct {
 	DSN         string
 	OTELEnabled bool
+
+	// ReplicaDSNs are optional read-only standbys. Reads fall back to master
+	// when the list is empty or every replica fails its health check.
+	ReplicaDSNs []string
+	// ReplicaCheckInterval defines how often replicas are pinged.
+	ReplicaCheckInterval time.Duration
+}
+
+type replica struct {
+	pool    *pgxpool.Pool
+	healthy atomic.Bool
 }
 
 func New(ctx context.Context, logger *slog.Logger, config ConnectionConfig) (*PgxDB, error) {
-	cfg, err := pgxpool.ParseConfig(config.DSN)
+	dbpool, err := newPool(ctx, logger, config.DSN, config.OTELEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := dbpool.Ping(ctx); err != nil {
+		return nil, errors.Internal("pinging database", errors.WithCause(err), errors.WithID("pg.pg.new"))
+	}
+
+	db := new(PgxDB)
+	{
+		db.logger = logger
+		db.master = dbpool
+		db.stop = make(chan struct{})
+	}
+
+	for _, dsn := range config.ReplicaDSNs {
+		if dsn == "" {
+			continue
+		}
+
+		replicaPool, err := newPool(ctx, logger, dsn, config.OTELEnabled)
+		if err != nil {
+			db.Close()
+
+			return nil, err
+		}
+
+		r := &replica{pool: replicaPool}
+		if err := replicaPool.Ping(ctx); err != nil {
+			// replica is optional, so it only goes out of rotation until the next check succeeds
+			logger.Warn("[DB] replica is unreachable on start", "error", err)
+		} else {
+			r.healthy.Store(true)
+		}
+
+		db.replicas = append(db.replicas, r)
+	}
+
+	if len(db.replicas) > 0 {
+		interval := config.ReplicaCheckInterval
+		if interval <= 0 {
+			interval = defaultReplicaCheckInterval
+		}
+
+		db.wg.Add(1)
+
+		go db.checkReplicas(interval)
+	}
+
+	return db, nil
+}
+
+func newPool(ctx context.Context, logger *slog.Logger, dsn string, otelEnabled bool) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(dsn)
 	if err != nil {
 		return nil, errors.InvalidArgument("parsing DSN", errors.WithCause(err), errors.WithID("pg.pg.new"))
 	}
 
-	if config.OTELEnabled {
+	if otelEnabled {
 		cfg.ConnConfig.Tracer = otelpgx.NewTracer(
 			otelpgx.WithTrimSQLInSpanName(),
 		)
@@ -38,31 +112,121 @@ func New(ctx context.Context, logger *slog.Logger, config ConnectionConfig) (*Pg
 		return nil, errors.Internal("creating DB connection pool", errors.WithCause(err), errors.WithID("pg.pg.new"))
 	}
 
-	if err := dbpool.Ping(ctx); err != nil {
-		return nil, errors.Internal("pinging database", errors.WithCause(err), errors.WithID("pg.pg.new"))
-	}
-
-	if config.OTELEnabled {
+	if otelEnabled {
 		err = otelpgx.RecordStats(
 			dbpool,
 			otelpgx.WithMinimumReadDBStatsInterval(10*time.Second),
 		)
 		if err != nil {
 			logger.Error("[DB] starting recording pool statistic", "error", err)
+			dbpool.Close()
 
 			return nil, errors.Internal("starting recording pool statistic", errors.WithCause(err), errors.WithID("pg.pg.new"))
 		}
 	}
 
-	db := new(PgxDB)
-	{
-		db.logger = logger
-		db.master = dbpool
-	}
-
-	return db, nil
+	return dbpool, nil
 }
 
 func (d *PgxDB) Master() *pgxpool.Pool {
 	return d.master
 }
+
+// Replica returns the next healthy replica pool in round-robin order.
+// It falls back to master when no replica is configured or available.
+func (d *PgxDB) Replica() *pgxpool.Pool {
+	total := len(d.replicas)
+	if total == 0 {
+		return d.master
+	}
+
+	// rotate over the healthy replicas only, so that one going down doesn't double the load of its neighbour
+	healthy := 0
+	for _, r := range d.replicas {
+		if r.healthy.Load() {
+			healthy++
+		}
+	}
+
+	if healthy == 0 {
+		return d.master
+	}
+
+	n := int(d.next.Add(1) % uint64(healthy))
+	for _, r := range d.replicas {
+		if !r.healthy.Load() {
+			continue
+		}
+
+		if n == 0 {
+			return r.pool
+		}
+
+		n--
+	}
+
+	// a replica went down between the two passes
+	return d.master
+}
+
+// Reader returns the pool for read-only queries: master if the context
+// was marked with [WithMaster], a replica otherwise.
+func (d *PgxDB) Reader(ctx context.Context) *pgxpool.Pool {
+	if IsMasterForced(ctx) {
+		return d.master
+	}
+
+	return d.Replica()
+}
+
+// Ping checks that the master accepts connections. Replicas are optional, so they don't take part.
+func (d *PgxDB) Ping(ctx context.Context) error {
+	return d.master.Ping(ctx)
+}
+
+// Close stops replica health checking and closes every pool.
+func (d *PgxDB) Close() {
+	close(d.stop)
+	d.wg.Wait()
+
+	for _, r := range d.replicas {
+		r.pool.Close()
+	}
+
+	d.master.Close()
+}
+
+func (d *PgxDB) checkReplicas(interval time.Duration) {
+	defer d.wg.Done()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-d.stop:
+			return
+		case <-ticker.C:
+			for _, r := range d.replicas {
+				d.checkReplica(r, interval)
+			}
+		}
+	}
+}
+
+func (d *PgxDB) checkReplica(r *replica, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	err := r.pool.Ping(ctx)
+	healthy := err == nil
+
+	if r.healthy.Swap(healthy) != healthy {
+		host := r.pool.Config().ConnConfig.Host
+		if healthy {
+			d.logger.Info("[DB] replica is back in rotation", "host", host)
+		} else {
+			d.logger.Warn("[DB] replica is out of rotation", "host", host, "error", err)
+		}
+	}
+}
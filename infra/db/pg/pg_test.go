@@ -0,0 +1,95 @@
+package pg
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// newTestPool returns a pool that never connected; nothing listens on port 1, so every ping fails fast.
+func newTestPool(t *testing.T) *pgxpool.Pool {
+	t.Helper()
+
+	pool, err := pgxpool.New(context.Background(), "postgres://postgres@127.0.0.1:1/webitel?connect_timeout=1")
+	if err != nil {
+		t.Fatalf("new pool: %v", err)
+	}
+
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+func newTestDB(t *testing.T, healthy ...bool) *PgxDB {
+	t.Helper()
+
+	db := &PgxDB{
+		master: newTestPool(t),
+		logger: slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	for _, h := range healthy {
+		r := &replica{pool: newTestPool(t)}
+		r.healthy.Store(h)
+		db.replicas = append(db.replicas, r)
+	}
+
+	return db
+}
+
+func TestReplicaRoundRobin(t *testing.T) {
+	db := newTestDB(t, true, false, true)
+
+	seen := make(map[*pgxpool.Pool]int)
+	for range 6 {
+		seen[db.Replica()]++
+	}
+
+	if seen[db.replicas[0].pool] != 3 || seen[db.replicas[2].pool] != 3 {
+		t.Fatalf("reads are not spread evenly over healthy replicas: %v", seen)
+	}
+
+	if seen[db.replicas[1].pool] != 0 || seen[db.master] != 0 {
+		t.Fatalf("reads went to an unhealthy replica or master: %v", seen)
+	}
+}
+
+func TestReplicaFallsBackToMaster(t *testing.T) {
+	if db := newTestDB(t); db.Replica() != db.master {
+		t.Fatal("without replicas reads must go to master")
+	}
+
+	if db := newTestDB(t, false, false); db.Replica() != db.master {
+		t.Fatal("without healthy replicas reads must go to master")
+	}
+}
+
+func TestReaderHonoursWithMaster(t *testing.T) {
+	db := newTestDB(t, true)
+
+	if db.Reader(context.Background()) != db.replicas[0].pool {
+		t.Fatal("reads must go to the replica by default")
+	}
+
+	if db.Reader(WithMaster(context.Background())) != db.master {
+		t.Fatal("reads marked WithMaster must go to master")
+	}
+}
+
+func TestCheckReplicaTakesFailingReplicaOutOfRotation(t *testing.T) {
+	db := newTestDB(t, true)
+
+	db.checkReplica(db.replicas[0], time.Second)
+
+	if db.replicas[0].healthy.Load() {
+		t.Fatal("unreachable replica must be marked unhealthy")
+	}
+
+	if db.Replica() != db.master {
+		t.Fatal("reads must fall back to master once the replica fails its check")
+	}
+}
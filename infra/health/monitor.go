@@ -0,0 +1,180 @@
+package health
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// Names of the dependencies services are checked against.
+const (
+	Postgres = "postgres"
+	Pubsub   = "pubsub"
+)
+
+const (
+	defaultCheckInterval = 10 * time.Second
+	defaultCheckTimeout  = 3 * time.Second
+)
+
+// Check probes a dependency; a nil error means it is available.
+type Check struct {
+	Name  string
+	Probe func(ctx context.Context) error
+}
+
+// Monitor drives the grpc.health.v1.Health statuses from periodic dependency checks.
+// A service is serving while every dependency it was watched with passes its check;
+// the overall "" service requires every check to pass.
+type Monitor struct {
+	server   *health.Server
+	checks   []Check
+	logger   *slog.Logger
+	interval time.Duration
+	timeout  time.Duration
+
+	mu       sync.Mutex
+	services map[string][]string
+	failing  map[string]error
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewMonitor(logger *slog.Logger, interval, timeout time.Duration, checks ...Check) *Monitor {
+	if interval <= 0 {
+		interval = defaultCheckInterval
+	}
+
+	if timeout <= 0 || timeout > interval {
+		timeout = min(defaultCheckTimeout, interval)
+	}
+
+	m := &Monitor{
+		server:   health.NewServer(),
+		checks:   checks,
+		logger:   logger,
+		interval: interval,
+		timeout:  timeout,
+		services: make(map[string][]string),
+		stop:     make(chan struct{}),
+	}
+
+	// nothing is known to work until the first round of checks
+	m.server.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	return m
+}
+
+// Server is the health service to register on the gRPC server.
+func (m *Monitor) Server() healthpb.HealthServer {
+	return m.server
+}
+
+// Watch reports the service as serving only while the named dependencies pass their checks.
+func (m *Monitor) Watch(service string, dependencies ...string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.services[service] = dependencies
+	m.server.SetServingStatus(service, m.statusLocked(dependencies))
+}
+
+// Start runs the first round of checks right away and then repeats them every interval.
+func (m *Monitor) Start() {
+	m.wg.Add(1)
+
+	go m.run()
+}
+
+// Shutdown reports every service as not serving from now on and stops the checks.
+// It is called first on graceful shutdown, so that clients stop sending new requests.
+func (m *Monitor) Shutdown() {
+	m.server.Shutdown()
+
+	select {
+	case <-m.stop:
+	default:
+		close(m.stop)
+	}
+
+	m.wg.Wait()
+}
+
+func (m *Monitor) run() {
+	defer m.wg.Done()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		m.probe()
+
+		select {
+		case <-m.stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (m *Monitor) probe() {
+	failing := make(map[string]error, len(m.checks))
+
+	for _, check := range m.checks {
+		ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+		err := check.Probe(ctx)
+		cancel()
+
+		if err != nil {
+			failing[check.Name] = err
+		}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for name, err := range failing {
+		if _, ok := m.failing[name]; !ok {
+			m.logger.Warn("[HEALTH] dependency is unavailable", "dependency", name, "error", err)
+		}
+	}
+
+	for name := range m.failing {
+		if _, ok := failing[name]; !ok {
+			m.logger.Info("[HEALTH] dependency is available again", "dependency", name)
+		}
+	}
+
+	m.failing = failing
+
+	for service, dependencies := range m.services {
+		m.server.SetServingStatus(service, m.statusLocked(dependencies))
+	}
+
+	overall := healthpb.HealthCheckResponse_SERVING
+	if len(failing) > 0 {
+		overall = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	m.server.SetServingStatus("", overall)
+}
+
+func (m *Monitor) statusLocked(dependencies []string) healthpb.HealthCheckResponse_ServingStatus {
+	// before the first round of checks m.failing is nil
+	if m.failing == nil {
+		return healthpb.HealthCheckResponse_NOT_SERVING
+	}
+
+	for _, name := range dependencies {
+		if _, ok := m.failing[name]; ok {
+			return healthpb.HealthCheckResponse_NOT_SERVING
+		}
+	}
+
+	return healthpb.HealthCheckResponse_SERVING
+}
@@ -0,0 +1,67 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestMonitorStatuses(t *testing.T) {
+	var brokerDown error
+
+	m := NewMonitor(slog.Default(), 0, 0,
+		Check{Name: Postgres, Probe: func(context.Context) error { return nil }},
+		Check{Name: Pubsub, Probe: func(context.Context) error { return brokerDown }},
+	)
+	m.Watch("reads", Postgres)
+	m.Watch("writes", Postgres, Pubsub)
+
+	status := func(service string) healthpb.HealthCheckResponse_ServingStatus {
+		t.Helper()
+
+		res, err := m.Server().Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("check %q: %v", service, err)
+		}
+
+		return res.GetStatus()
+	}
+
+	if got := status("writes"); got != healthpb.HealthCheckResponse_NOT_SERVING {
+		t.Fatalf("before first check: writes = %v, want NOT_SERVING", got)
+	}
+
+	m.probe()
+
+	for _, service := range []string{"", "reads", "writes"} {
+		if got := status(service); got != healthpb.HealthCheckResponse_SERVING {
+			t.Fatalf("all up: %q = %v, want SERVING", service, got)
+		}
+	}
+
+	brokerDown = errors.New("connection refused")
+	m.probe()
+
+	if got := status("reads"); got != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("broker down: reads = %v, want SERVING", got)
+	}
+
+	for _, service := range []string{"", "writes"} {
+		if got := status(service); got != healthpb.HealthCheckResponse_NOT_SERVING {
+			t.Fatalf("broker down: %q = %v, want NOT_SERVING", service, got)
+		}
+	}
+
+	brokerDown = nil
+	m.Start()
+	m.Shutdown()
+
+	for _, service := range []string{"", "reads", "writes"} {
+		if got := status(service); got != healthpb.HealthCheckResponse_NOT_SERVING {
+			t.Fatalf("after shutdown: %q = %v, want NOT_SERVING", service, got)
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package amqp
 
 import (
+	"sync"
+
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill-amqp/v3/pkg/amqp"
 	"github.com/ThreeDotsLabs/watermill/message"
@@ -19,6 +21,9 @@ const (
 type Factory struct {
 	url    string
 	logger watermill.LoggerAdapter
+
+	mu          sync.Mutex
+	connections []*amqp.ConnectionWrapper
 }
 
 func NewFactory(url string, logger watermill.LoggerAdapter) (*Factory, error) {
@@ -63,7 +68,14 @@ func (f *Factory) BuildSubscriber(name string, subConfig *factory.SubscriberConf
 		TopologyBuilder: &amqp.DefaultTopologyBuilder{},
 	}
 
-	return amqp.NewSubscriber(conf, f.logger)
+	subscriber, err := amqp.NewSubscriber(conf, f.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	f.track(subscriber.ConnectionWrapper)
+
+	return subscriber, nil
 }
 
 func (f *Factory) BuildPublisher(pubConfig *factory.PublisherConfig) (message.Publisher, error) {
@@ -87,5 +99,37 @@ func (f *Factory) BuildPublisher(pubConfig *factory.PublisherConfig) (message.Pu
 		TopologyBuilder: &amqp.DefaultTopologyBuilder{},
 	}
 
-	return amqp.NewPublisher(conf, f.logger)
+	publisher, err := amqp.NewPublisher(conf, f.logger)
+	if err != nil {
+		return nil, err
+	}
+
+	f.track(publisher.ConnectionWrapper)
+
+	return publisher, nil
+}
+
+// Check implements [factory.Factory]. Connections reconnect on their own, so a failing check recovers with them.
+func (f *Factory) Check() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, conn := range f.connections {
+		if conn.Closed() {
+			return errors.Unavailable("amqp connection is closed", errors.WithID("amqp.factory.check"))
+		}
+
+		if !conn.IsConnected() {
+			return errors.Unavailable("amqp connection is down", errors.WithID("amqp.factory.check"))
+		}
+	}
+
+	return nil
+}
+
+func (f *Factory) track(conn *amqp.ConnectionWrapper) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.connections = append(f.connections, conn)
 }
@@ -15,6 +15,8 @@ type PublisherFactory interface {
 type Factory interface {
 	SubscriberFactory
 	PublisherFactory
+	// Check reports an error while any connection of the built publishers and subscribers is down.
+	Check() error
 }
 
 type ExchangeConfig struct {
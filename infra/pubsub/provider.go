@@ -10,6 +10,7 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/webitel/im-contact-service/config"
+	"github.com/webitel/im-contact-service/infra/health"
 	"github.com/webitel/im-contact-service/infra/pubsub/factory"
 	"github.com/webitel/im-contact-service/infra/pubsub/factory/amqp"
 )
@@ -24,6 +25,10 @@ var Module = fx.Module("pubsub",
 		fx.Annotate(
 			ProvidePubSub,
 		),
+		fx.Annotate(
+			ProvideHealthCheck,
+			fx.ResultTags(`group:"health_checks"`),
+		),
 	),
 )
 
@@ -68,6 +73,21 @@ func ProvidePubSub(cfg *config.Config, l *slog.Logger, lc fx.Lifecycle) (Provide
 	return NewDefaultProvider(router, pubsubFactory)
 }
 
+// ProvideHealthCheck reports the broker as unavailable while the router is closed
+// or any publisher or subscriber connection is down.
+func ProvideHealthCheck(p Provider) health.Check {
+	return health.Check{
+		Name: health.Pubsub,
+		Probe: func(_ context.Context) error {
+			if p.GetRouter().IsClosed() {
+				return errors.New("router is closed")
+			}
+
+			return p.GetFactory().Check()
+		},
+	}
+}
+
 type DefaultProvider struct {
 	router  *message.Router
 	factory factory.Factory
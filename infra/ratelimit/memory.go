@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepThreshold is the number of buckets after which full buckets are dropped.
+const sweepThreshold = 10_000
+
+type memoryBucket struct {
+	tokens float64
+	at     time.Time
+}
+
+// Memory is a per-process token-bucket limiter.
+type Memory struct {
+	bucket Bucket
+	now    func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*memoryBucket
+}
+
+func NewMemory(bucket Bucket) *Memory {
+	return &Memory{bucket: bucket, now: time.Now, buckets: make(map[string]*memoryBucket)}
+}
+
+func (m *Memory) Take(_ context.Context, key string, n int) (Result, error) {
+	if err := m.bucket.checkSize(n); err != nil {
+		return Result{}, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+
+	b, ok := m.buckets[key]
+	if !ok {
+		if len(m.buckets) >= sweepThreshold {
+			m.sweep(now)
+		}
+
+		b = &memoryBucket{tokens: float64(m.bucket.Capacity), at: now}
+		m.buckets[key] = b
+	}
+
+	m.refill(b, now)
+
+	if b.tokens >= float64(n) {
+		b.tokens -= float64(n)
+
+		return Result{Allowed: true}, nil
+	}
+
+	wait := math.Ceil((float64(n) - b.tokens) / m.bucket.refillPerMs())
+
+	return Result{RetryAfter: time.Duration(wait) * time.Millisecond}, nil
+}
+
+func (m *Memory) refill(b *memoryBucket, now time.Time) {
+	elapsed := float64(now.Sub(b.at).Milliseconds())
+	b.tokens = math.Min(float64(m.bucket.Capacity), b.tokens+elapsed*m.bucket.refillPerMs())
+	b.at = now
+}
+
+// sweep drops buckets that are full again, since they are equal to a new one.
+func (m *Memory) sweep(now time.Time) {
+	for key, b := range m.buckets {
+		m.refill(b, now)
+
+		if b.tokens >= float64(m.bucket.Capacity) {
+			delete(m.buckets, key)
+		}
+	}
+}
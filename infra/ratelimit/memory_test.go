@@ -0,0 +1,49 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+func TestMemoryTake(t *testing.T) {
+	now := time.Unix(0, 0)
+
+	m := NewMemory(Bucket{Capacity: 3, Window: 3 * time.Second})
+	m.now = func() time.Time { return now }
+
+	ctx := context.Background()
+
+	for i := range 3 {
+		if res, _ := m.Take(ctx, "sender", 1); !res.Allowed {
+			t.Fatalf("take %d: expected allowed", i)
+		}
+	}
+
+	res, _ := m.Take(ctx, "sender", 1)
+	if res.Allowed {
+		t.Fatal("expected empty bucket to deny")
+	}
+
+	if res.RetryAfter != time.Second {
+		t.Fatalf("retry after = %v, want 1s", res.RetryAfter)
+	}
+
+	if res, _ := m.Take(ctx, "other", 1); !res.Allowed {
+		t.Fatal("expected buckets to be per key")
+	}
+
+	now = now.Add(time.Second)
+
+	if res, _ := m.Take(ctx, "sender", 1); !res.Allowed {
+		t.Fatal("expected a token after refill")
+	}
+
+	if _, err := m.Take(ctx, "sender", 4); errors.Code(err) != codes.InvalidArgument {
+		t.Fatalf("expected request above capacity to be rejected, got %v", err)
+	}
+}
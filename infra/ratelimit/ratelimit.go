@@ -0,0 +1,116 @@
+// Package ratelimit provides token-bucket limiters keyed by an arbitrary string.
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+	"go.uber.org/fx"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/config"
+)
+
+// Result is the outcome of taking tokens from a bucket.
+type Result struct {
+	Allowed bool
+	// RetryAfter is how long the caller should wait for enough tokens when not allowed.
+	RetryAfter time.Duration
+}
+
+// Limiter takes n tokens from the bucket identified by key.
+// Taking more tokens than the bucket capacity fails with InvalidArgument.
+type Limiter interface {
+	Take(ctx context.Context, key string, n int) (Result, error)
+}
+
+// Bucket holds up to Capacity tokens that refill evenly over Window.
+type Bucket struct {
+	Capacity int
+	Window   time.Duration
+}
+
+// refillPerMs is the number of tokens added to the bucket every millisecond.
+func (b Bucket) refillPerMs() float64 {
+	return float64(b.Capacity) / float64(b.Window.Milliseconds())
+}
+
+// checkSize rejects taking more tokens than the bucket can ever hold,
+// since waiting for a refill would never let such a request through.
+func (b Bucket) checkSize(n int) error {
+	if n > b.Capacity {
+		return errors.InvalidArgument(fmt.Sprintf("%d exceeds the limit of %d per %s", n, b.Capacity, b.Window), errors.WithID("ratelimit.bucket.check_size"))
+	}
+
+	return nil
+}
+
+// InviteLimiter limits invites per sender contact.
+type InviteLimiter Limiter
+
+var Module = fx.Module("ratelimit",
+	fx.Provide(
+		ProvideInviteLimiter,
+	),
+)
+
+func ProvideInviteLimiter(cfg *config.Config, l *slog.Logger, lc fx.Lifecycle) InviteLimiter {
+	if cfg.Limits.InvitesPerWindow <= 0 {
+		return Unlimited{}
+	}
+
+	bucket := Bucket{Capacity: cfg.Limits.InvitesPerWindow, Window: cfg.Limits.InviteWindow}
+
+	client := goredis.NewClient(&goredis.Options{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+
+	lc.Append(fx.Hook{
+		OnStop: func(_ context.Context) error {
+			return client.Close()
+		},
+	})
+
+	return NewFallback(
+		NewRedis(client, "im_contact:invite_limit:", bucket),
+		NewMemory(bucket),
+		l.With("component", "invite_limiter"),
+	)
+}
+
+// Unlimited allows everything.
+type Unlimited struct{}
+
+func (Unlimited) Take(context.Context, string, int) (Result, error) {
+	return Result{Allowed: true}, nil
+}
+
+// Fallback uses the primary limiter and switches to the secondary one while the primary fails,
+// e.g. to keep limiting per instance when Redis is unreachable.
+type Fallback struct {
+	primary   Limiter
+	secondary Limiter
+	logger    *slog.Logger
+}
+
+func NewFallback(primary, secondary Limiter, logger *slog.Logger) *Fallback {
+	return &Fallback{primary: primary, secondary: secondary, logger: logger}
+}
+
+func (f *Fallback) Take(ctx context.Context, key string, n int) (Result, error) {
+	result, err := f.primary.Take(ctx, key, n)
+	if err == nil || errors.Code(err) == codes.InvalidArgument {
+		return result, err
+	}
+
+	f.logger.Warn("primary limiter failed, using fallback", "error", err)
+
+	return f.secondary.Take(ctx, key, n)
+}
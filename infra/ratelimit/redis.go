@@ -0,0 +1,73 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+// takeScript refills and takes tokens atomically, using the Redis clock so that all instances agree.
+// Returns {allowed, retry_after_ms}.
+var takeScript = goredis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local refill_per_ms = tonumber(ARGV[2])
+local requested = tonumber(ARGV[3])
+
+local t = redis.call('TIME')
+local now = t[1] * 1000 + math.floor(t[2] / 1000)
+
+local bucket = redis.call('HMGET', KEYS[1], 'tokens', 'at')
+local tokens = tonumber(bucket[1])
+local at = tonumber(bucket[2])
+if tokens == nil or at == nil then
+  tokens = capacity
+  at = now
+end
+
+tokens = math.min(capacity, tokens + math.max(0, now - at) * refill_per_ms)
+
+local allowed = 0
+local retry = 0
+if tokens >= requested then
+  tokens = tokens - requested
+  allowed = 1
+else
+  retry = math.ceil((requested - tokens) / refill_per_ms)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'at', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(capacity / refill_per_ms))
+
+return {allowed, retry}
+`)
+
+// Redis is a token-bucket limiter shared by all service instances.
+type Redis struct {
+	client *goredis.Client
+	prefix string
+	bucket Bucket
+}
+
+func NewRedis(client *goredis.Client, prefix string, bucket Bucket) *Redis {
+	return &Redis{client: client, prefix: prefix, bucket: bucket}
+}
+
+func (r *Redis) Take(ctx context.Context, key string, n int) (Result, error) {
+	if err := r.bucket.checkSize(n); err != nil {
+		return Result{}, err
+	}
+
+	values, err := takeScript.Run(ctx, r.client, []string{r.prefix + key}, r.bucket.Capacity, r.bucket.refillPerMs(), n).Int64Slice()
+	if err != nil {
+		return Result{}, errors.Internal("taking rate limit tokens", errors.WithCause(err), errors.WithID("ratelimit.redis.take"))
+	}
+
+	if len(values) != 2 {
+		return Result{}, errors.Internal("unexpected rate limit script result", errors.WithID("ratelimit.redis.take"))
+	}
+
+	return Result{Allowed: values[0] == 1, RetryAfter: time.Duration(values[1]) * time.Millisecond}, nil
+}
@@ -0,0 +1,180 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.uber.org/fx"
+
+	"github.com/webitel/im-contact-service/config"
+	"github.com/webitel/im-contact-service/gen/openapi"
+	grpcsrv "github.com/webitel/im-contact-service/infra/server/grpc"
+	infratls "github.com/webitel/im-contact-service/infra/tls"
+)
+
+// OpenAPIPath is where the OpenAPI document of the gateway is served.
+const OpenAPIPath = "/openapi.json"
+
+const readHeaderTimeout = 10 * time.Second
+
+var Module = fx.Module("gateway_server",
+	fx.Provide(
+		ProvideServer,
+	),
+)
+
+func ProvideServer(conf *config.Config, logger *slog.Logger, tls *infratls.Config, lc fx.Lifecycle) (*Server, error) {
+	srv, err := New(conf.Gateway.Addr, func(c *Config) error {
+		c.TLS = tls.Server
+		c.Logger = logger
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			if srv.listener == nil {
+				logger.Info("http gateway is disabled")
+
+				return nil
+			}
+
+			go func() {
+				logger.Info(fmt.Sprintf("listen http gateway %s", srv.listener.Addr()))
+
+				if err := srv.Listen(); err != nil {
+					logger.Error("http gateway error", "err", err)
+				}
+			}()
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			if err := srv.Shutdown(ctx); err != nil {
+				logger.Error("error stopping http gateway", "err", err.Error())
+
+				return err
+			}
+
+			return nil
+		},
+	})
+
+	return srv, nil
+}
+
+// Server serves the annotated gRPC services as HTTP/JSON. Handlers call the gRPC server in-process,
+// so requests pass the same error, logging, auth and validation interceptors as gRPC calls.
+type Server struct {
+	mux      *runtime.ServeMux
+	http     *http.Server
+	log      *slog.Logger
+	listener net.Listener
+}
+
+type Config struct {
+	// TLS serves the gateway over HTTPS; client certificates are not requested, callers use bearer tokens.
+	TLS    *tls.Config
+	Logger *slog.Logger
+}
+
+type Option func(*Config) error
+
+// New creates the gateway; with an empty addr handlers can still be registered but nothing is served.
+func New(addr string, opts ...Option) (*Server, error) {
+	var conf Config
+	for _, opt := range opts {
+		if err := opt(&conf); err != nil {
+			return nil, err
+		}
+	}
+
+	log := conf.Logger
+	if log == nil {
+		log = slog.Default()
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(headerMatcher),
+	)
+
+	if err := mux.HandlePath(http.MethodGet, OpenAPIPath, serveOpenAPI); err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		mux: mux,
+		log: log,
+		http: &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: readHeaderTimeout,
+		},
+	}
+
+	if addr == "" {
+		return s, nil
+	}
+
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	if conf.TLS != nil {
+		serverTLS := conf.TLS.Clone()
+		serverTLS.ClientAuth = tls.NoClientCert
+		l = tls.NewListener(l, serverTLS)
+	}
+
+	s.listener = l
+
+	return s, nil
+}
+
+// Mux is where the generated Register*HandlerClient functions add their routes.
+func (s *Server) Mux() *runtime.ServeMux {
+	return s.mux
+}
+
+func (s *Server) Listen() error {
+	if err := s.http.Serve(s.listener); !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Server) Shutdown(ctx context.Context) error {
+	if s.listener == nil {
+		return nil
+	}
+
+	s.log.Debug("receive shutdown http gateway")
+
+	return s.http.Shutdown(ctx)
+}
+
+// headerMatcher forwards the read-your-writes header besides the defaults; Authorization is always forwarded.
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, grpcsrv.ReadMasterHeader) {
+		return grpcsrv.ReadMasterHeader, true
+	}
+
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func serveOpenAPI(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(openapi.Contact)
+}
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	impb "github.com/webitel/im-contact-service/gen/go/contact/v1"
+	"github.com/webitel/im-contact-service/infra/auth"
+	grpcsrv "github.com/webitel/im-contact-service/infra/server/grpc"
+)
+
+const testTokenSecret = "secret"
+
+// testToken signs an HS256 token for domain 7 acting as the given contact.
+func testToken(t *testing.T, contactID uuid.UUID) string {
+	t.Helper()
+
+	encode := func(v any) string {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return base64.RawURLEncoding.EncodeToString(raw)
+	}
+
+	unsigned := encode(map[string]string{"alg": "HS256", "typ": "JWT"}) + "." +
+		encode(map[string]any{"dc": 7, "uid": 10, "contact_id": contactID.String(), "exp": time.Now().Add(time.Hour).Unix()})
+
+	mac := hmac.New(sha256.New, []byte(testTokenSecret))
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// privacyServer allows everything and keeps the identities of the calls that reached it.
+type privacyServer struct {
+	impb.UnimplementedContactPrivacyServer
+
+	callers []*auth.Identity
+}
+
+func (s *privacyServer) CanSend(ctx context.Context, _ *impb.CanSendRequest) (*impb.CanSendResponse, error) {
+	identity, _ := auth.FromContext(ctx)
+	s.callers = append(s.callers, identity)
+
+	return &impb.CanSendResponse{Allowed: true}, nil
+}
+
+// newTestGateway registers privacy on a gRPC server and serves it through the gateway mux the way the handler module does.
+func newTestGateway(t *testing.T, privacy impb.ContactPrivacyServer) *httptest.Server {
+	t.Helper()
+
+	logger := slog.New(slog.DiscardHandler)
+
+	grpcServer, err := grpcsrv.New("127.0.0.1:0", func(c *grpcsrv.Config) error {
+		c.Logger = logger
+		c.Tokens = auth.NewTokenVerifier(testTokenSecret)
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("new grpc server: %v", err)
+	}
+
+	t.Cleanup(func() { _ = grpcServer.Shutdown(context.Background()) })
+
+	grpcServer.RegisterService(&impb.ContactPrivacy_ServiceDesc, privacy)
+
+	gateway, err := New("", func(c *Config) error {
+		c.Logger = logger
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("new gateway: %v", err)
+	}
+
+	if err := impb.RegisterContactPrivacyHandlerClient(context.Background(), gateway.Mux(), impb.NewContactPrivacyClient(grpcServer.LocalConn())); err != nil {
+		t.Fatalf("register handler: %v", err)
+	}
+
+	srv := httptest.NewServer(gateway.Mux())
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestGatewayRunsInterceptors(t *testing.T) {
+	var (
+		privacy   = &privacyServer{}
+		srv       = newTestGateway(t, privacy)
+		contactID = uuid.New()
+		valid     = "/v1/privacy/can_send?from=" + contactID.String() + "&to=" + uuid.NewString()
+	)
+
+	tests := []struct {
+		name   string
+		path   string
+		token  string
+		status int
+	}{
+		{name: "without a token", path: valid, status: http.StatusUnauthorized},
+		{name: "with an invalid token", path: valid, token: "Bearer broken", status: http.StatusUnauthorized},
+		{name: "invalid request", path: "/v1/privacy/can_send?from=nope&to=" + uuid.NewString(), token: "Bearer " + testToken(t, contactID), status: http.StatusBadRequest},
+		{name: "with a token", path: valid, token: "Bearer " + testToken(t, contactID), status: http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := http.NewRequest(http.MethodGet, srv.URL+tt.path, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.token != "" {
+				req.Header.Set("Authorization", tt.token)
+			}
+
+			res, err := srv.Client().Do(req)
+			if err != nil {
+				t.Fatalf("request: %v", err)
+			}
+			defer res.Body.Close()
+
+			if res.StatusCode != tt.status {
+				t.Fatalf("status = %d, want %d", res.StatusCode, tt.status)
+			}
+		})
+	}
+
+	// only the authenticated and valid request reaches the service
+	if len(privacy.callers) != 1 {
+		t.Fatalf("service was called %d times, want once", len(privacy.callers))
+	}
+
+	if caller := privacy.callers[0]; caller == nil || caller.DomainID != 7 || caller.ContactID != contactID {
+		t.Fatalf("caller = %+v, want the token identity", caller)
+	}
+}
+
+func TestGatewayServesOpenAPI(t *testing.T) {
+	srv := newTestGateway(t, &privacyServer{})
+
+	res, err := srv.Client().Get(srv.URL + OpenAPIPath)
+	if err != nil {
+		t.Fatalf("request: %v", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want %d", res.StatusCode, http.StatusOK)
+	}
+
+	if ct := res.Header.Get("Content-Type"); ct != "application/json" {
+		t.Fatalf("content type = %q, want application/json", ct)
+	}
+
+	var doc struct {
+		Paths map[string]any `json:"paths"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&doc); err != nil {
+		t.Fatalf("decoding the document: %v", err)
+	}
+
+	if _, ok := doc.Paths["/v1/privacy/can_send"]; !ok {
+		t.Fatalf("document has no /v1/privacy/can_send path")
+	}
+}
@@ -0,0 +1,96 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/infra/auth"
+)
+
+// Metadata a caller authenticates with. A bearer token carries the whole identity;
+// a service authenticated by its client certificate names the domain and contact it acts for in headers.
+const (
+	AuthorizationHeader = "authorization"
+	DomainHeader        = "x-webitel-domain"
+	ContactHeader       = "x-webitel-contact"
+)
+
+func authInterceptor(verifier *auth.TokenVerifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		identity, err := authenticate(ctx, verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(auth.NewContext(ctx, identity), req)
+	}
+}
+
+// authenticate prefers a bearer token, so that a service forwarding a user token acts with the user rights.
+func authenticate(ctx context.Context, verifier *auth.TokenVerifier) (*auth.Identity, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if values := md.Get(AuthorizationHeader); len(values) > 0 {
+		token, ok := strings.CutPrefix(values[0], "Bearer ")
+		if !ok {
+			return nil, errors.Unauthenticated("authorization must be a bearer token", errors.WithID("grpc.auth.authenticate"))
+		}
+
+		return verifier.Verify(strings.TrimSpace(token))
+	}
+
+	subject, ok := clientCertificateSubject(ctx)
+	if !ok {
+		return nil, errors.Unauthenticated("caller is not authenticated", errors.WithID("grpc.auth.authenticate"))
+	}
+
+	identity := &auth.Identity{Subject: subject, Service: true}
+
+	if values := md.Get(DomainHeader); len(values) > 0 {
+		domainID, err := strconv.Atoi(values[0])
+		if err != nil || domainID <= 0 {
+			return nil, errors.InvalidArgument("invalid "+DomainHeader+" header", errors.WithID("grpc.auth.authenticate"))
+		}
+
+		identity.DomainID = domainID
+	}
+
+	if values := md.Get(ContactHeader); len(values) > 0 {
+		contactID, err := uuid.Parse(values[0])
+		if err != nil {
+			return nil, errors.InvalidArgument("invalid "+ContactHeader+" header", errors.WithID("grpc.auth.authenticate"))
+		}
+
+		if identity.DomainID == 0 {
+			return nil, errors.InvalidArgument(ContactHeader+" requires "+DomainHeader, errors.WithID("grpc.auth.authenticate"))
+		}
+
+		identity.ContactID = contactID
+	}
+
+	return identity, nil
+}
+
+// clientCertificateSubject returns the common name of a client certificate verified during the TLS handshake.
+func clientCertificateSubject(ctx context.Context) (string, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+
+	return info.State.VerifiedChains[0][0].Subject.CommonName, true
+}
@@ -0,0 +1,151 @@
+package server
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/infra/auth"
+)
+
+const testTokenSecret = "secret"
+
+// testToken signs an HS256 token for domain 7 acting as the given contact.
+func testToken(t *testing.T, contactID uuid.UUID) string {
+	t.Helper()
+
+	encode := func(v any) string {
+		raw, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return base64.RawURLEncoding.EncodeToString(raw)
+	}
+
+	unsigned := encode(map[string]string{"alg": "HS256", "typ": "JWT"}) + "." +
+		encode(map[string]any{"dc": 7, "uid": 10, "contact_id": contactID.String(), "exp": time.Now().Add(time.Hour).Unix()})
+
+	mac := hmac.New(sha256.New, []byte(testTokenSecret))
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// withClientCertificate makes ctx look like a call over mTLS with a verified client certificate.
+func withClientCertificate(ctx context.Context, commonName string) context.Context {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: commonName}}
+
+	return peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{
+		State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+	}})
+}
+
+func TestAuthenticate(t *testing.T) {
+	var (
+		verifier  = auth.NewTokenVerifier(testTokenSecret)
+		contactID = uuid.New()
+	)
+
+	tests := []struct {
+		name string
+		mtls bool
+		md   []string
+		want *auth.Identity
+		code codes.Code
+	}{
+		{
+			name: "bearer token",
+			md:   []string{AuthorizationHeader, "Bearer " + testToken(t, contactID)},
+			want: &auth.Identity{DomainID: 7, UserID: 10, ContactID: contactID},
+		},
+		{
+			name: "bearer token wins over the certificate",
+			mtls: true,
+			md:   []string{AuthorizationHeader, "Bearer " + testToken(t, contactID), DomainHeader, "1"},
+			want: &auth.Identity{DomainID: 7, UserID: 10, ContactID: contactID},
+		},
+		{
+			name: "non-bearer authorization",
+			mtls: true,
+			md:   []string{AuthorizationHeader, "Basic dXNlcjpwYXNz"},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "invalid bearer token",
+			md:   []string{AuthorizationHeader, "Bearer invalid"},
+			code: codes.Unauthenticated,
+		},
+		{
+			name: "service without a domain acts in any domain",
+			mtls: true,
+			want: &auth.Identity{Subject: "im-gateway", Service: true},
+		},
+		{
+			name: "service in a domain",
+			mtls: true,
+			md:   []string{DomainHeader, "1"},
+			want: &auth.Identity{DomainID: 1, Subject: "im-gateway", Service: true},
+		},
+		{
+			name: "service acting as a contact",
+			mtls: true,
+			md:   []string{DomainHeader, "1", ContactHeader, contactID.String()},
+			want: &auth.Identity{DomainID: 1, ContactID: contactID, Subject: "im-gateway", Service: true},
+		},
+		{
+			name: "contact without a domain",
+			mtls: true,
+			md:   []string{ContactHeader, contactID.String()},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "invalid domain",
+			mtls: true,
+			md:   []string{DomainHeader, "0"},
+			code: codes.InvalidArgument,
+		},
+		{
+			name: "headers without a certificate",
+			md:   []string{DomainHeader, "1"},
+			code: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(tt.md...))
+			if tt.mtls {
+				ctx = withClientCertificate(ctx, "im-gateway")
+			}
+
+			identity, err := authenticate(ctx, verifier)
+			if errors.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+
+			if tt.want == nil {
+				return
+			}
+
+			if *identity != *tt.want {
+				t.Fatalf("identity = %+v, want %+v", identity, tt.want)
+			}
+		})
+	}
+}
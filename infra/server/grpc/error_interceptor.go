@@ -5,6 +5,12 @@ import (
 	"log/slog"
 
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+	intrcp "github.com/webitel/webitel-go-kit/pkg/interceptors"
 )
 
 func interceptorLogger(l *slog.Logger) logging.Logger {
@@ -12,3 +18,40 @@ func interceptorLogger(l *slog.Logger) logging.Logger {
 		l.Log(ctx, slog.Level(lvl), msg, fields...)
 	})
 }
+
+// errorInterceptor converts errors to statuses with the go-kit interceptor, which drops status details,
+// and puts back the details of errors that carry them, e.g. google.rpc.ErrorInfo of privacy denials.
+// Status errors of other interceptors, e.g. validation, keep their code instead of turning into Internal.
+func errorInterceptor() grpc.UnaryServerInterceptor {
+	convert := intrcp.UnaryServerErrorInterceptor()
+
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		var details []protoadapt.MessageV1
+
+		resp, err := convert(ctx, req, info, func(ctx context.Context, req any) (any, error) {
+			resp, err := handler(ctx, req)
+			if err != nil && errors.Value(err, errors.ErrKeyCode) == nil {
+				if st, ok := status.FromError(err); ok {
+					err = errors.New(st.Message(), errors.WithCode(st.Code()))
+				}
+			}
+
+			var detailed interface{ ErrorDetails() []protoadapt.MessageV1 }
+			if errors.As(err, &detailed) {
+				details = detailed.ErrorDetails()
+			}
+
+			return resp, err
+		})
+		if err == nil || len(details) == 0 {
+			return resp, err
+		}
+
+		withDetails, detailsErr := status.Convert(err).WithDetails(details...)
+		if detailsErr != nil {
+			return nil, err
+		}
+
+		return nil, withDetails.Err()
+	}
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+type detailedError struct {
+	error
+}
+
+func (e detailedError) Unwrap() error {
+	return e.error
+}
+
+func (e detailedError) ErrorDetails() []protoadapt.MessageV1 {
+	return []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: "DENIED"}}
+}
+
+func TestErrorInterceptorKeepsDetails(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	_, err := errorInterceptor()(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return nil, detailedError{errors.Forbidden("denied")}
+	})
+
+	st := status.Convert(err)
+	if st.Code() != codes.PermissionDenied {
+		t.Fatalf("code = %v, want PermissionDenied", st.Code())
+	}
+
+	details := st.Details()
+	if len(details) != 1 {
+		t.Fatalf("details = %v, want one ErrorInfo", details)
+	}
+
+	if reason := details[0].(*errdetails.ErrorInfo).GetReason(); reason != "DENIED" {
+		t.Fatalf("reason = %q, want DENIED", reason)
+	}
+
+	_, err = errorInterceptor()(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return nil, errors.NotFound("missing")
+	})
+
+	if st := status.Convert(err); st.Code() != codes.NotFound || len(st.Details()) != 0 {
+		t.Fatalf("plain error: code = %v, details = %v", st.Code(), st.Details())
+	}
+}
+
+func TestErrorInterceptorKeepsStatusCode(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}
+
+	_, err := errorInterceptor()(context.Background(), nil, info, func(context.Context, any) (any, error) {
+		return nil, status.Error(codes.InvalidArgument, "validation error")
+	})
+
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", code)
+	}
+}
@@ -0,0 +1,61 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestLocalConnRunsInterceptors(t *testing.T) {
+	var calls []string
+
+	record := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			calls = append(calls, name+" "+info.FullMethod+" "+md.Get(ReadMasterHeader)[0])
+
+			return handler(ctx, req)
+		}
+	}
+
+	conn := newLocalConn(chainUnaryInterceptors(record("first"), record("second")))
+
+	server := health.NewServer()
+	server.SetServingStatus("contacts", healthpb.HealthCheckResponse_SERVING)
+	conn.register(&healthpb.Health_ServiceDesc, server)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), ReadMasterHeader, "true")
+
+	res, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: "contacts"})
+	if err != nil {
+		t.Fatalf("check: %v", err)
+	}
+
+	if res.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Fatalf("status = %v, want SERVING", res.GetStatus())
+	}
+
+	want := []string{
+		"first /grpc.health.v1.Health/Check true",
+		"second /grpc.health.v1.Health/Check true",
+	}
+	if len(calls) != len(want) || calls[0] != want[0] || calls[1] != want[1] {
+		t.Fatalf("interceptor calls = %q, want %q", calls, want)
+	}
+
+	_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: "unknown"})
+	if status.Code(err) != codes.NotFound {
+		t.Fatalf("unknown service: err = %v, want NotFound", err)
+	}
+
+	err = conn.Invoke(ctx, "/grpc.health.v1.Health/Missing", &healthpb.HealthCheckRequest{}, &healthpb.HealthCheckResponse{})
+	if status.Code(err) != codes.Unimplemented {
+		t.Fatalf("missing method: err = %v, want Unimplemented", err)
+	}
+}
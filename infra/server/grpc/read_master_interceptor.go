@@ -0,0 +1,27 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/webitel/im-contact-service/infra/db/pg"
+)
+
+// ReadMasterHeader is the metadata key a client sets to "true" to get read-your-writes
+// consistency: every read of the call is then served by master instead of a replica.
+const ReadMasterHeader = "x-read-master"
+
+func readMasterInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(ReadMasterHeader); len(values) > 0 && strings.EqualFold(values[0], "true") {
+				ctx = pg.WithMaster(ctx)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/webitel/im-contact-service/infra/db/pg"
+)
+
+func TestReadMasterInterceptor(t *testing.T) {
+	tests := []struct {
+		name string
+		md   metadata.MD
+		want bool
+	}{
+		{name: "no metadata", want: false},
+		{name: "header absent", md: metadata.Pairs("x-other", "true"), want: false},
+		{name: "true", md: metadata.Pairs(ReadMasterHeader, "true"), want: true},
+		{name: "case insensitive", md: metadata.Pairs(ReadMasterHeader, "TRUE"), want: true},
+		{name: "false", md: metadata.Pairs(ReadMasterHeader, "false"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tt.md)
+			}
+
+			var forced bool
+
+			_, err := readMasterInterceptor()(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, _ any) (any, error) {
+				forced = pg.IsMasterForced(ctx)
+
+				return nil, nil
+			})
+			if err != nil {
+				t.Fatalf("interceptor: %v", err)
+			}
+
+			if forced != tt.want {
+				t.Fatalf("master forced = %v, want %v", forced, tt.want)
+			}
+		})
+	}
+}
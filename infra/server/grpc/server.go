@@ -8,19 +8,23 @@ import (
 	"net"
 	"os"
 	"strconv"
+	"time"
 
 	"buf.build/go/protovalidate"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors"
 	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/logging"
 	validatemiddleware "github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/protovalidate"
+	"github.com/grpc-ecosystem/go-grpc-middleware/v2/interceptors/selector"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/fx"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-
-	intrcp "github.com/webitel/webitel-go-kit/pkg/interceptors"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 
 	"github.com/webitel/im-contact-service/config"
+	"github.com/webitel/im-contact-service/infra/auth"
+	"github.com/webitel/im-contact-service/infra/health"
 	infratls "github.com/webitel/im-contact-service/infra/tls"
 )
 
@@ -28,14 +32,18 @@ var Module = fx.Module("grpc_server",
 	fx.Provide(
 		fx.Annotate(
 			ProvideServer,
+			fx.ParamTags(``, ``, ``, ``, `group:"health_checks"`),
 		),
 	),
 )
 
-func ProvideServer(conf *config.Config, logger *slog.Logger, tls *infratls.Config, lc fx.Lifecycle) (*Server, error) {
+func ProvideServer(conf *config.Config, logger *slog.Logger, tls *infratls.Config, lc fx.Lifecycle, checks []health.Check) (*Server, error) {
 	srv, err := New(conf.Service.Addr, func(c *Config) error {
 		c.TLS = tls.Server.Clone()
 		c.Logger = logger
+		c.Tokens = auth.NewTokenVerifier(conf.Auth.TokenSecret)
+		c.Health = health.NewMonitor(logger, conf.Health.CheckInterval, conf.Health.CheckTimeout, checks...)
+		c.DrainDelay = conf.Health.DrainDelay
 
 		return nil
 	})
@@ -55,8 +63,8 @@ func ProvideServer(conf *config.Config, logger *slog.Logger, tls *infratls.Confi
 
 			return nil
 		},
-		OnStop: func(_ context.Context) error {
-			if err := srv.Shutdown(); err != nil {
+		OnStop: func(ctx context.Context) error {
+			if err := srv.Shutdown(ctx); err != nil {
 				logger.Error("error stopping grpc server", "err", err.Error())
 
 				return err
@@ -77,11 +85,21 @@ type Server struct {
 	port     int
 	log      *slog.Logger
 	listener net.Listener
+	health   *health.Monitor
+	local    *localConn
+
+	drainDelay time.Duration
 }
 
 type Config struct {
 	TLS    *tls.Config
 	Logger *slog.Logger
+	// Tokens verifies bearer tokens of callers; without it only mTLS callers are accepted.
+	Tokens *auth.TokenVerifier
+	// Health drives the grpc.health.v1.Health statuses; without it only the overall status is reported.
+	Health *health.Monitor
+	// DrainDelay is how long Shutdown keeps serving after reporting NOT_SERVING.
+	DrainDelay time.Duration
 }
 
 type Option func(*Config) error
@@ -112,6 +130,16 @@ func New(addr string, opts ...Option) (*Server, error) {
 		grpcTLS = credentials.NewTLS(conf.TLS)
 	}
 
+	tokens := conf.Tokens
+	if tokens == nil {
+		tokens = auth.NewTokenVerifier("")
+	}
+
+	monitor := conf.Health
+	if monitor == nil {
+		monitor = health.NewMonitor(log, 0, 0)
+	}
+
 	validator, err := protovalidate.New()
 	if err != nil {
 		return nil, err
@@ -130,16 +158,26 @@ func New(addr string, opts ...Option) (*Server, error) {
 		logging.WithLevels(logging.DefaultServerCodeToLevel),
 	}
 
+	unary := []grpc.UnaryServerInterceptor{
+		errorInterceptor(),
+		// probes come every few seconds from anonymous callers, they are neither logged nor authenticated
+		selector.UnaryServerInterceptor(
+			logging.UnaryServerInterceptor(interceptorLogger(rpcLogger), loggingOpts...),
+			selector.MatchFunc(notHealthCheck),
+		),
+		selector.UnaryServerInterceptor(authInterceptor(tokens), selector.MatchFunc(notHealthCheck)),
+		validatemiddleware.UnaryServerInterceptor(validator),
+		readMasterInterceptor(),
+	}
+
 	s := grpc.NewServer(
 		grpc.Creds(grpcTLS),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-		grpc.ChainUnaryInterceptor(
-			intrcp.UnaryServerErrorInterceptor(),
-			logging.UnaryServerInterceptor(interceptorLogger(rpcLogger), loggingOpts...),
-			validatemiddleware.UnaryServerInterceptor(validator),
-		),
+		grpc.ChainUnaryInterceptor(unary...),
 	)
 
+	healthpb.RegisterHealthServer(s, monitor.Server())
+
 	l, err := net.Listen("tcp", addr)
 	if err != nil {
 		return nil, err
@@ -163,21 +201,61 @@ func New(addr string, opts ...Option) (*Server, error) {
 		host:     h,
 		port:     port,
 		listener: l,
+		health:   monitor,
+		local:    newLocalConn(chainUnaryInterceptors(unary...)),
+
+		drainDelay: conf.DrainDelay,
 	}, nil
 }
 
+// Health reports the per-service statuses; services are watched with the dependencies they need.
+func (s *Server) Health() *health.Monitor {
+	return s.health
+}
+
+// RegisterService registers the service on the gRPC server and makes it callable in-process through LocalConn.
+func (s *Server) RegisterService(desc *grpc.ServiceDesc, impl any) {
+	s.Server.RegisterService(desc, impl)
+	s.local.register(desc, impl)
+}
+
+// LocalConn calls the registered services in-process with the same interceptors as network calls.
+func (s *Server) LocalConn() grpc.ClientConnInterface {
+	return s.local
+}
+
 func (s *Server) Listen() error {
+	s.health.Start()
+
 	return s.Serve(s.listener)
 }
 
-func (s *Server) Shutdown() error {
+// Shutdown reports NOT_SERVING, keeps serving for the drain delay so that clients and balancers
+// notice the status, and then stops gracefully. The delay is cut short when ctx is done.
+func (s *Server) Shutdown(ctx context.Context) error {
 	s.log.Debug("receive shutdown grpc")
+	s.health.Shutdown()
+
+	if s.drainDelay > 0 {
+		drain := time.NewTimer(s.drainDelay)
+		defer drain.Stop()
+
+		select {
+		case <-drain.C:
+		case <-ctx.Done():
+		}
+	}
+
 	err := s.listener.Close()
 	s.GracefulStop()
 
 	return err
 }
 
+func notHealthCheck(_ context.Context, call interceptors.CallMeta) bool {
+	return call.Service != healthpb.Health_ServiceDesc.ServiceName
+}
+
 func (s *Server) Host() string {
 	if e, ok := os.LookupEnv("PROXY_GRPC_HOST"); ok {
 		return e
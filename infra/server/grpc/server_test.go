@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// startTestServer starts a server without health checks and waits until it reports SERVING.
+func startTestServer(t *testing.T, drainDelay time.Duration) (*Server, healthpb.HealthClient) {
+	t.Helper()
+
+	srv, err := New("127.0.0.1:0", func(c *Config) error {
+		c.Logger = slog.New(slog.DiscardHandler)
+		c.DrainDelay = drainDelay
+
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("new server: %v", err)
+	}
+
+	go func() { _ = srv.Listen() }()
+
+	conn, err := grpc.NewClient(srv.listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+
+	t.Cleanup(func() { _ = conn.Close() })
+
+	client := healthpb.NewHealthClient(conn)
+	waitStatus(t, client, healthpb.HealthCheckResponse_SERVING)
+
+	return srv, client
+}
+
+func waitStatus(t *testing.T, client healthpb.HealthClient, want healthpb.HealthCheckResponse_ServingStatus) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+
+	for {
+		res, err := client.Check(context.Background(), &healthpb.HealthCheckRequest{})
+		if err == nil && res.GetStatus() == want {
+			return
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatalf("status is not %v: %v, %v", want, res.GetStatus(), err)
+		}
+
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestShutdownServesNotServingDuringDrain(t *testing.T) {
+	const drainDelay = 500 * time.Millisecond
+
+	srv, client := startTestServer(t, drainDelay)
+
+	started := time.Now()
+	stopped := make(chan error, 1)
+
+	go func() { stopped <- srv.Shutdown(context.Background()) }()
+
+	// the server still answers while draining, with the status balancers act on
+	waitStatus(t, client, healthpb.HealthCheckResponse_NOT_SERVING)
+
+	if err := <-stopped; err != nil {
+		t.Fatalf("shutdown: %v", err)
+	}
+
+	if elapsed := time.Since(started); elapsed < drainDelay {
+		t.Fatalf("shutdown took %v, want at least the drain delay of %v", elapsed, drainDelay)
+	}
+}
+
+func TestShutdownDrainStopsWithContext(t *testing.T) {
+	srv, _ := startTestServer(t, time.Hour)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	done := make(chan error, 1)
+
+	go func() { done <- srv.Shutdown(ctx) }()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("shutdown did not stop draining when the context was done")
+	}
+}
@@ -0,0 +1,55 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/im-contact-service/internal/model"
+)
+
+const (
+	ViaConsentGrantedTopic string = "contact.via.consent.granted."
+	ViaConsentRevokedTopic string = "contact.via.consent.revoked."
+)
+
+// ViaConsentChanged is published for every recorded consent change, under the granted or revoked topic.
+type ViaConsentChanged struct {
+	Base `json:",inline"`
+
+	ConsentID  uuid.UUID           `json:"consent_id"`
+	ContactID  uuid.UUID           `json:"contact_id"`
+	Via        string              `json:"via"`
+	Status     model.ConsentStatus `json:"status"`
+	Source     string              `json:"source"`
+	LegalBasis string              `json:"legal_basis"`
+	ChangedAt  time.Time           `json:"changed_at"`
+}
+
+var _ Event = (*ViaConsentChanged)(nil)
+
+func NewViaConsentChangedEvent(consent *model.ViaConsent) *ViaConsentChanged {
+	if consent == nil {
+		return nil
+	}
+
+	topic := ViaConsentGrantedTopic
+	if consent.Status == model.ConsentStatusRevoked {
+		topic = ViaConsentRevokedTopic
+	}
+
+	return &ViaConsentChanged{
+		Base: Base{
+			ID:        consent.ContactID,
+			TopicName: topic + consent.ContactID.String() + "." + consent.Via,
+			Timestamp: consent.CreatedAt,
+		},
+		ConsentID:  consent.ID,
+		ContactID:  consent.ContactID,
+		Via:        consent.Via,
+		Status:     consent.Status,
+		Source:     consent.Source,
+		LegalBasis: consent.LegalBasis,
+		ChangedAt:  consent.OccurredAt,
+	}
+}
@@ -0,0 +1,21 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DeliveryFailedTopic = "delivery.failed.#"
+)
+
+// DeliveryFailed is published by the message pipeline when a message could not be delivered to a via,
+// e.g. a bounced email or an undeliverable SMS.
+type DeliveryFailed struct {
+	ContactID uuid.UUID `json:"contact_id"`
+	Via       string    `json:"via"`
+	Kind      string    `json:"kind,omitempty"`
+	Reason    string    `json:"reason,omitempty"`
+	Timestamp time.Time `json:"occurred_at"`
+}
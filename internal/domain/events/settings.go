@@ -0,0 +1,63 @@
+package events
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/im-contact-service/internal/model"
+)
+
+const (
+	SettingsCreatedTopic = "contact.settings.created"
+	SettingsUpdatedTopic = "contact.settings.updated"
+)
+
+type SettingsCreated struct {
+	Base
+
+	ContactID uuid.UUID              `json:"contact_id"`
+	Settings  *model.ContactSettings `json:"settings"`
+}
+
+var _ Event = (*SettingsCreated)(nil)
+
+func NewSettingsCreated(settings *model.ContactSettings) *SettingsCreated {
+	return &SettingsCreated{
+		Base: Base{
+			ID:        settings.ContactID,
+			TopicName: SettingsCreatedTopic,
+			Timestamp: settings.UpdatedAt,
+		},
+		ContactID: settings.ContactID,
+		Settings:  settings,
+	}
+}
+
+type SettingsUpdated struct {
+	Base
+
+	ContactID uuid.UUID              `json:"contact_id"`
+	Old       *model.ContactSettings `json:"old"`
+	New       *model.ContactSettings `json:"new"`
+}
+
+var _ Event = (*SettingsUpdated)(nil)
+
+func NewSettingsUpdated(old, updated *model.ContactSettings) *SettingsUpdated {
+	timestamp := updated.UpdatedAt
+	if timestamp.IsZero() {
+		timestamp = time.Now().UTC()
+	}
+
+	return &SettingsUpdated{
+		Base: Base{
+			ID:        updated.ContactID,
+			TopicName: SettingsUpdatedTopic,
+			Timestamp: timestamp,
+		},
+		ContactID: updated.ContactID,
+		Old:       old,
+		New:       updated,
+	}
+}
@@ -1,18 +1,69 @@
 package events
 
 import (
+	"time"
+
+	"github.com/google/uuid"
+
 	"github.com/webitel/im-contact-service/internal/model"
 )
 
 const (
 	ViaCreatedTopic string = "contact.via.created."
 	ViaUpdatedTopic string = "contact.via.updated."
+	ViaDeletedTopic string = "contact.via.deleted."
+
+	ViaVerificationRequestedTopic string = "contact.via.verification_requested."
+	ViaVerifiedTopic              string = "contact.via.verified."
 )
 
+// ViaPayload is the via record shared by the created, updated and deleted events,
+// so consumers don't have to parse the contact and via out of the routing key.
+type ViaPayload struct {
+	ContactID     uuid.UUID      `json:"contact_id"`
+	DomainID      int            `json:"domain_id"`
+	Via           string         `json:"via"`
+	Kind          model.ViaKind  `json:"kind"`
+	Disable       bool           `json:"disable"`
+	DisableReason *string        `json:"disable_reason,omitempty"`
+	Metadata      map[string]any `json:"metadata,omitempty"`
+	Primary       bool           `json:"primary"`
+	Priority      int            `json:"priority"`
+	VerifiedAt    *time.Time     `json:"verified_at,omitempty"`
+	FailureCount  int            `json:"failure_count"`
+	LastFailureAt *time.Time     `json:"last_failure_at,omitempty"`
+	DisabledUntil *time.Time     `json:"disabled_until,omitempty"`
+	CreatedBy     int            `json:"created_by"`
+	UpdatedBy     int            `json:"updated_by"`
+}
+
+func newViaPayload(via *model.ViaCommunication) ViaPayload {
+	return ViaPayload{
+		ContactID:     via.ContactID,
+		DomainID:      via.DomainID,
+		Via:           via.Via,
+		Kind:          via.Kind,
+		Disable:       via.Disable,
+		DisableReason: via.DisableReason,
+		Metadata:      via.Metadata,
+		Primary:       via.Primary,
+		Priority:      via.Priority,
+		VerifiedAt:    via.VerifiedAt,
+		FailureCount:  via.FailureCount,
+		LastFailureAt: via.LastFailureAt,
+		DisabledUntil: via.DisabledUntil,
+		CreatedBy:     via.CreatedBy,
+		UpdatedBy:     via.UpdatedBy,
+	}
+}
+
 type ViaCreated struct {
 	Base `json:",inline"`
+	ViaPayload
 }
 
+var _ Event = (*ViaCreated)(nil)
+
 func NewViaCreatedEvent(via *model.ViaCommunication) *ViaCreated {
 	if via == nil {
 		return nil
@@ -24,17 +75,17 @@ func NewViaCreatedEvent(via *model.ViaCommunication) *ViaCreated {
 			TopicName: ViaCreatedTopic + via.ContactID.String() + "." + via.Via,
 			Timestamp: via.CreatedAt,
 		},
+		ViaPayload: newViaPayload(via),
 	}
 }
 
 type ViaUpdated struct {
 	Base `json:",inline"`
-
-	Via           string  `json:"via"`
-	Disable       bool    `json:"disable"`
-	DisableReason *string `json:"disable_reason,omitempty"`
+	ViaPayload
 }
 
+var _ Event = (*ViaUpdated)(nil)
+
 func NewViaUpdatedEvent(via *model.ViaCommunication) *ViaUpdated {
 	if via == nil {
 		return nil
@@ -46,8 +97,77 @@ func NewViaUpdatedEvent(via *model.ViaCommunication) *ViaUpdated {
 			TopicName: ViaUpdatedTopic + via.ContactID.String() + "." + via.Via,
 			Timestamp: via.UpdatedAt,
 		},
-		Via:           via.Via,
-		Disable:       via.Disable,
-		DisableReason: via.DisableReason,
+		ViaPayload: newViaPayload(via),
+	}
+}
+
+// ViaDeleted carries the record as it was right before the deletion.
+type ViaDeleted struct {
+	Base `json:",inline"`
+	ViaPayload
+}
+
+var _ Event = (*ViaDeleted)(nil)
+
+func NewViaDeletedEvent(via *model.ViaCommunication) *ViaDeleted {
+	if via == nil {
+		return nil
+	}
+
+	return &ViaDeleted{
+		Base: Base{
+			ID:        via.ContactID,
+			TopicName: ViaDeletedTopic + via.ContactID.String() + "." + via.Via,
+			Timestamp: time.Now().UTC(),
+		},
+		ViaPayload: newViaPayload(via),
+	}
+}
+
+// ViaVerificationRequested asks the delivery service to send the one-time code to the via.
+// It is the only place the plain code leaves the service.
+type ViaVerificationRequested struct {
+	Base `json:",inline"`
+
+	Via       string    `json:"via"`
+	Code      string    `json:"code"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewViaVerificationRequestedEvent(verification *model.ViaVerification, code string) *ViaVerificationRequested {
+	if verification == nil {
+		return nil
+	}
+
+	return &ViaVerificationRequested{
+		Base: Base{
+			ID:        verification.ContactID,
+			TopicName: ViaVerificationRequestedTopic + verification.ContactID.String() + "." + verification.Via,
+			Timestamp: verification.CreatedAt,
+		},
+		Via:       verification.Via,
+		Code:      code,
+		ExpiresAt: verification.ExpiresAt,
+	}
+}
+
+type ViaVerified struct {
+	Base `json:",inline"`
+
+	Via string `json:"via"`
+}
+
+func NewViaVerifiedEvent(via *model.ViaCommunication) *ViaVerified {
+	if via == nil || via.VerifiedAt == nil {
+		return nil
+	}
+
+	return &ViaVerified{
+		Base: Base{
+			ID:        via.ContactID,
+			TopicName: ViaVerifiedTopic + via.ContactID.String() + "." + via.Via,
+			Timestamp: *via.VerifiedAt,
+		},
+		Via: via.Via,
 	}
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/webitel/im-contact-service/internal/domain/events"
+	"github.com/webitel/im-contact-service/internal/model"
 )
 
 type DomainEventsHandler interface {
@@ -11,12 +12,17 @@ type DomainEventsHandler interface {
 	DeleteBotByFlowID(ctx context.Context, flowID string) error
 }
 
+type DeliveryEventsHandler interface {
+	RegisterDeliveryFailure(ctx context.Context, command *model.RegisterDeliveryFailureCommand) error
+}
+
 type MessageHandler struct {
-	service DomainEventsHandler
+	service  DomainEventsHandler
+	delivery DeliveryEventsHandler
 }
 
-func NewMessageHandler(svc DomainEventsHandler) *MessageHandler {
-	return &MessageHandler{service: svc}
+func NewMessageHandler(svc DomainEventsHandler, delivery DeliveryEventsHandler) *MessageHandler {
+	return &MessageHandler{service: svc, delivery: delivery}
 }
 
 func (h *MessageHandler) OnDomainDeleted(ctx context.Context, event events.DomainDeleted) error {
@@ -26,3 +32,13 @@ func (h *MessageHandler) OnDomainDeleted(ctx context.Context, event events.Domai
 func (h *MessageHandler) OnFlowSchemaDelete(ctx context.Context, event events.FlowSchemaDeleted) error {
 	return h.service.DeleteBotByFlowID(ctx, event.FlowID)
 }
+
+func (h *MessageHandler) OnDeliveryFailed(ctx context.Context, event events.DeliveryFailed) error {
+	return h.delivery.RegisterDeliveryFailure(ctx, &model.RegisterDeliveryFailureCommand{
+		ContactID: event.ContactID,
+		Via:       event.Via,
+		Kind:      model.ViaKind(event.Kind),
+		Reason:    event.Reason,
+		FailedAt:  event.Timestamp,
+	})
+}
@@ -16,12 +16,14 @@ import (
 const (
 	WebitelGoExchange              = "webitel"
 	FlowSchemaDomainEventsExchange = "event"
+	DeliveryEventsExchange         = "im.delivery"
 )
 
 // Queue names
 const (
 	DomainDeletedQueue    = "im_contacts.domain_delete"
 	FlowSchemaDeleteQueue = "im_contacts.flow_delete"
+	DeliveryFailedQueue   = "im_contacts.delivery_failed"
 )
 
 func RegisterHandlers(
@@ -47,6 +49,12 @@ func RegisterHandlers(
 			handler:  bind(h.OnFlowSchemaDelete),
 			exchange: FlowSchemaDomainEventsExchange,
 		},
+		{
+			topic:    events.DeliveryFailedTopic,
+			queue:    DeliveryFailedQueue,
+			handler:  bind(h.OnDeliveryFailed),
+			exchange: DeliveryEventsExchange,
+		},
 	}
 
 	for _, s := range subscriptions {
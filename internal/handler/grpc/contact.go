@@ -41,6 +41,11 @@ func (c *ContactServer) SearchContact(ctx context.Context, request *impb.SearchC
 	page, size := ParsePagination(request.GetPage(), request.GetSize())
 	domainID := int(request.GetDomainId())
 
+	initiatorID, err := mapper.ConvertOptionalUUID(request.InitiatorContactId)
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid initiator contact id", errors.WithCause(err))
+	}
+
 	contacts, err := c.handler.Search(ctx, &model.ContactSearchRequest{
 		Page:     page,
 		Size:     size, // + 1,
@@ -55,6 +60,9 @@ func (c *ContactServer) SearchContact(ctx context.Context, request *impb.SearchC
 		IDs:      ids,
 		OnlyBots: request.OnlyBots,
 		Via:      request.GetVia(),
+
+		InitiatorContactID: initiatorID,
+		CreatedBy:          mapper.ConvertInt64sToInts(request.GetCreatedBy()),
 	})
 	if err != nil {
 		return nil, err
@@ -193,3 +201,26 @@ func (c *ContactServer) Locate(ctx context.Context, request *impb.LocateContactR
 		Item: pbContact,
 	}, nil
 }
+
+func (c *ContactServer) ResolveByVia(ctx context.Context, request *impb.ResolveByViaRequest) (*impb.ResolveByViaResponse, error) {
+	resolved, err := c.handler.ResolveByVia(ctx, &model.ResolveByViaRequest{
+		DomainID: int(request.GetDomainId()),
+		Kind:     mapper.ConvertInViaKind(request.GetKind()),
+		Value:    request.GetValue(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*impb.ResolvedContact, 0, len(resolved))
+	for _, r := range resolved {
+		item := &impb.ResolvedContact{Contact: mapper.MarshalContact(&r.Contact)}
+		if r.MatchedVia != nil {
+			item.Via = mapper.MarshalViaList([]*model.ViaCommunication{r.MatchedVia})[0]
+		}
+
+		items = append(items, item)
+	}
+
+	return &impb.ResolveByViaResponse{Items: items}, nil
+}
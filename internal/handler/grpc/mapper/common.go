@@ -25,10 +25,53 @@ func ConvertTimeToInt64(in time.Time) int64 {
 	return in.UnixMilli()
 }
 
+// ConvertInt64ToOptionalTime maps zero Unix milliseconds to nil.
+func ConvertInt64ToOptionalTime(in int64) *time.Time {
+	if in == 0 {
+		return nil
+	}
+
+	t := time.UnixMilli(in).UTC()
+
+	return &t
+}
+
+func ConvertInt64sToInts(in []int64) []int {
+	if len(in) == 0 {
+		return nil
+	}
+
+	out := make([]int, len(in))
+	for i, v := range in {
+		out[i] = int(v)
+	}
+
+	return out
+}
+
 func ConvertInUserFilter(in contact.UserFilter) model.UserFilter {
 	return model.UserFilter(in)
 }
 
+// ConvertInContactAllowlist keeps nil for an unset allowlist, so that it is left unchanged on update.
+func ConvertInContactAllowlist(in *contact.ContactAllowlist) ([]uuid.UUID, error) {
+	if in == nil {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(in.ContactIds))
+	for _, raw := range in.ContactIds {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
 func ConvertOutUserFilter(in model.UserFilter) contact.UserFilter {
 	return contact.UserFilter(in)
 }
@@ -44,3 +87,41 @@ func ConvertInt64ToInt(in int64) int {
 func ConvertInt32ToInt(in int32) int {
 	return int(in)
 }
+
+var viaKinds = map[contact.ViaKind]model.ViaKind{
+	contact.ViaKind_VIA_KIND_PHONE:    model.ViaKindPhone,
+	contact.ViaKind_VIA_KIND_EMAIL:    model.ViaKindEmail,
+	contact.ViaKind_VIA_KIND_TELEGRAM: model.ViaKindTelegram,
+	contact.ViaKind_VIA_KIND_WHATSAPP: model.ViaKindWhatsApp,
+	contact.ViaKind_VIA_KIND_CUSTOM:   model.ViaKindCustom,
+}
+
+// ConvertInViaKind maps VIA_KIND_UNSPECIFIED to an empty kind.
+func ConvertInViaKind(in contact.ViaKind) model.ViaKind {
+	return viaKinds[in]
+}
+
+func ConvertInViaKinds(in []contact.ViaKind) []model.ViaKind {
+	if len(in) == 0 {
+		return nil
+	}
+
+	kinds := make([]model.ViaKind, 0, len(in))
+	for _, kind := range in {
+		if k := ConvertInViaKind(kind); k != "" {
+			kinds = append(kinds, k)
+		}
+	}
+
+	return kinds
+}
+
+func ConvertOutViaKind(in model.ViaKind) contact.ViaKind {
+	for out, kind := range viaKinds {
+		if kind == in {
+			return out
+		}
+	}
+
+	return contact.ViaKind_VIA_KIND_UNSPECIFIED
+}
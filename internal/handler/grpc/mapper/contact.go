@@ -15,8 +15,10 @@ import (
 // goverter:extend ConvertInt32ToInt
 // goverter:extend github.com/google/uuid:Parse
 type ContactInConverter interface {
+	// goverter:ignore UpdatedBy
 	ConvertUpdateRequest(*impb.UpdateContactRequest) (*model.UpdateContactRequest, error)
 	// goverter:map FieldMask.Paths Fields
+	// goverter:ignore UpdatedBy
 	// goverter:useZeroValueOnPointerInconsistency
 	ConvertPartialUpdateRequest(*impb.PatchContactRequest) (*model.PartialUpdateContactRequest, error)
 	ConvertDeleteRequest(*impb.DeleteContactRequest) (*model.DeleteContactRequest, error)
@@ -38,6 +40,15 @@ func MarshalViaList(vias []*model.ViaCommunication) []*impb.Via {
 			CreatedAt:     via.CreatedAtUTCUnix(),
 			UpdatedAt:     via.UpdatedAtUTCUnix(),
 			Metadata:      md,
+			VerifiedAt:    via.VerifiedAtUTCUnix(),
+			Kind:          ConvertOutViaKind(via.Kind),
+			Primary:       via.Primary,
+			Priority:      int32(via.Priority),
+			FailureCount:  int32(via.FailureCount),
+			LastFailureAt: via.LastFailureAtUTCUnix(),
+			DisabledUntil: via.DisabledUntilUTCUnix(),
+			CreatedBy:     int64(via.CreatedBy),
+			UpdatedBy:     int64(via.UpdatedBy),
 		}
 	}
 
@@ -63,6 +74,8 @@ func MarshalContact(contact *model.Contact) *impb.Contact {
 		DomainId:  int32(contact.DomainID),
 		IsBot:     contact.IsBot,
 		Vias:      MarshalViaList(contact.Via),
+		CreatedBy: int64(contact.CreatedBy),
+		UpdatedBy: int64(contact.UpdatedBy),
 	}
 }
 
@@ -89,6 +89,53 @@ func (c *ContactInConverterImpl) ConvertUpdateRequest(source *v1.UpdateContactRe
 
 type PrivacyInMapperImpl struct{}
 
+func (c *PrivacyInMapperImpl) ConvertBatchCanInviteRequest(source *v1.BatchCanInviteRequest) (*model.BatchCanInviteRequest, error) {
+	var pModelBatchCanInviteRequest *model.BatchCanInviteRequest
+	if source != nil {
+		var modelBatchCanInviteRequest model.BatchCanInviteRequest
+		uuidUUID, err := uuid.Parse((*source).From)
+		if err != nil {
+			return nil, err
+		}
+		modelBatchCanInviteRequest.From = uuidUUID
+		if (*source).To != nil {
+			modelBatchCanInviteRequest.To = make([]uuid.UUID, len((*source).To))
+			for i := 0; i < len((*source).To); i++ {
+				uuidUUID2, err := uuid.Parse((*source).To[i])
+				if err != nil {
+					return nil, err
+				}
+				modelBatchCanInviteRequest.To[i] = uuidUUID2
+			}
+		}
+		pModelBatchCanInviteRequest = &modelBatchCanInviteRequest
+	}
+	return pModelBatchCanInviteRequest, nil
+}
+func (c *PrivacyInMapperImpl) ConvertBatchCanSendRequest(source *v1.BatchCanSendRequest) (*model.BatchCanSendRequest, error) {
+	var pModelBatchCanSendRequest *model.BatchCanSendRequest
+	if source != nil {
+		var modelBatchCanSendRequest model.BatchCanSendRequest
+		uuidUUID, err := uuid.Parse((*source).From)
+		if err != nil {
+			return nil, err
+		}
+		modelBatchCanSendRequest.From = uuidUUID
+		if (*source).To != nil {
+			modelBatchCanSendRequest.To = make([]uuid.UUID, len((*source).To))
+			for i := 0; i < len((*source).To); i++ {
+				uuidUUID2, err := uuid.Parse((*source).To[i])
+				if err != nil {
+					return nil, err
+				}
+				modelBatchCanSendRequest.To[i] = uuidUUID2
+			}
+		}
+		pModelBatchCanSendRequest = &modelBatchCanSendRequest
+	}
+	return pModelBatchCanSendRequest, nil
+}
+
 func (c *PrivacyInMapperImpl) ConvertCanInviteRequest(source *v1.CanInviteRequest) (*model.CanInviteRequest, error) {
 	var pModelCanInviteRequest *model.CanInviteRequest
 	if source != nil {
@@ -164,6 +211,19 @@ func (c *SettingsInConverterImpl) ConvertUpdateSettingsRequest(source *v1.Update
 			modelUserFilter := mapper.ConvertInUserFilter(*(*source).AllowInvitesFrom)
 			modelUpdateContactSettingsRequest.AllowInvitesFrom = &modelUserFilter
 		}
+		if (*source).AllowMessagesFrom != nil {
+			modelUserFilter2 := mapper.ConvertInUserFilter(*(*source).AllowMessagesFrom)
+			modelUpdateContactSettingsRequest.AllowMessagesFrom = &modelUserFilter2
+		}
+		uuidList, err := mapper.ConvertInContactAllowlist((*source).AllowedContacts)
+		if err != nil {
+			return nil, err
+		}
+		modelUpdateContactSettingsRequest.AllowedContacts = uuidList
+		if (*source).Discoverable != nil {
+			xbool := *(*source).Discoverable
+			modelUpdateContactSettingsRequest.Discoverable = &xbool
+		}
 		pModelUpdateContactSettingsRequest = &modelUpdateContactSettingsRequest
 	}
 	return pModelUpdateContactSettingsRequest, nil
@@ -179,6 +239,14 @@ func (c *SettingsOutConverterImpl) ConvertSettings(source *model.ContactSettings
 		contactSettings.ContactId = mapper.ConvertUUID((*source).ContactID)
 		contactSettings.UpdatedAt = mapper.ConvertTimeToInt64((*source).UpdatedAt)
 		contactSettings.AllowInvitesFrom = mapper.ConvertOutUserFilter((*source).AllowInvitesFrom)
+		if (*source).AllowedContacts != nil {
+			contactSettings.AllowedContacts = make([]string, len((*source).AllowedContacts))
+			for i := 0; i < len((*source).AllowedContacts); i++ {
+				contactSettings.AllowedContacts[i] = mapper.ConvertUUID((*source).AllowedContacts[i])
+			}
+		}
+		contactSettings.AllowMessagesFrom = mapper.ConvertOutUserFilter((*source).AllowMessagesFrom)
+		contactSettings.Discoverable = (*source).Discoverable
 		pContactSettings = &contactSettings
 	}
 	return pContactSettings, nil
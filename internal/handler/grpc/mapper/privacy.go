@@ -14,4 +14,6 @@ import (
 type PrivacyInMapper interface {
 	ConvertCanSendRequest(*impb.CanSendRequest) (*model.CanSendRequest, error)
 	ConvertCanInviteRequest(*impb.CanInviteRequest) (*model.CanInviteRequest, error)
+	ConvertBatchCanSendRequest(*impb.BatchCanSendRequest) (*model.BatchCanSendRequest, error)
+	ConvertBatchCanInviteRequest(*impb.BatchCanInviteRequest) (*model.BatchCanInviteRequest, error)
 }
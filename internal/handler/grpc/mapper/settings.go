@@ -13,11 +13,13 @@ import (
 // goverter:extend ConvertOptionalUUID
 // goverter:extend time:UnixMilli
 // goverter:extend ConvertInUserFilter
+// goverter:extend ConvertInContactAllowlist
 type SettingsInConverter interface {
 	// goverter:useZeroValueOnPointerInconsistency
 	ConvertGetSettingsRequest(*contact.GetContactSettingsRequest) (*model.GetContactSettingsRequest, error)
 	// goverter:useZeroValueOnPointerInconsistency
 	// goverter:map IntiatorContactId InitiatorContactID
+	// goverter:ignore UpdatedBy
 	ConvertUpdateSettingsRequest(*contact.UpdateContactSettingsRequest) (*model.UpdateContactSettingsRequest, error)
 }
 
@@ -1,9 +1,13 @@
 package grpc
 
 import (
+	"context"
+
 	"go.uber.org/fx"
 
 	impb "github.com/webitel/im-contact-service/gen/go/contact/v1"
+	"github.com/webitel/im-contact-service/infra/health"
+	gatewaysrv "github.com/webitel/im-contact-service/infra/server/gateway"
 	grpcsrv "github.com/webitel/im-contact-service/infra/server/grpc"
 )
 
@@ -13,35 +17,57 @@ var Module = fx.Module("grpc",
 		NewContactSettingsServer,
 		NewPrivacyServer,
 		newViaServer,
+		NewSettingsTemplateServer,
+		NewPrivacyRuleServer,
 	),
 	fx.Invoke(
 		RegisterContactService,
 		RegisterContactSettingsService,
 		RegisterContactPrivacyService,
 		RegisterViaServer,
+		RegisterSettingsTemplateServer,
+		RegisterPrivacyRuleServer,
 	),
 )
 
-func RegisterContactService(server *grpcsrv.Server, service *ContactServer, _ fx.Lifecycle) error {
-	impb.RegisterContactsServer(server.Server, service)
+func RegisterContactService(server *grpcsrv.Server, service *ContactServer, gateway *gatewaysrv.Server) error {
+	impb.RegisterContactsServer(server, service)
+	server.Health().Watch(impb.Contacts_ServiceDesc.ServiceName, health.Postgres, health.Pubsub)
 
-	return nil
+	return impb.RegisterContactsHandlerClient(context.Background(), gateway.Mux(), impb.NewContactsClient(server.LocalConn()))
 }
 
-func RegisterContactSettingsService(server *grpcsrv.Server, service *ContactSettingsServer, _ fx.Lifecycle) error {
-	impb.RegisterContactSettingsServer(server.Server, service)
+func RegisterContactSettingsService(server *grpcsrv.Server, service *ContactSettingsServer, gateway *gatewaysrv.Server) error {
+	impb.RegisterContactSettingsServer(server, service)
+	server.Health().Watch(impb.ContactSettings_ServiceDesc.ServiceName, health.Postgres, health.Pubsub)
 
-	return nil
+	return impb.RegisterContactSettingsHandlerClient(context.Background(), gateway.Mux(), impb.NewContactSettingsClient(server.LocalConn()))
+}
+
+func RegisterContactPrivacyService(server *grpcsrv.Server, service *ContactPrivacyServer, gateway *gatewaysrv.Server) error {
+	impb.RegisterContactPrivacyServer(server, service)
+	server.Health().Watch(impb.ContactPrivacy_ServiceDesc.ServiceName, health.Postgres)
+
+	return impb.RegisterContactPrivacyHandlerClient(context.Background(), gateway.Mux(), impb.NewContactPrivacyClient(server.LocalConn()))
+}
+
+func RegisterViaServer(server *grpcsrv.Server, srv *ViaServer, gateway *gatewaysrv.Server) error {
+	impb.RegisterViasServer(server, srv)
+	server.Health().Watch(impb.Vias_ServiceDesc.ServiceName, health.Postgres, health.Pubsub)
+
+	return impb.RegisterViasHandlerClient(context.Background(), gateway.Mux(), impb.NewViasClient(server.LocalConn()))
 }
 
-func RegisterContactPrivacyService(server *grpcsrv.Server, service *ContactPrivacyServer, _ fx.Lifecycle) error {
-	impb.RegisterContactPrivacyServer(server.Server, service)
+func RegisterSettingsTemplateServer(server *grpcsrv.Server, srv *SettingsTemplateServer, _ fx.Lifecycle) error {
+	impb.RegisterSettingsTemplatesServer(server, srv)
+	server.Health().Watch(impb.SettingsTemplates_ServiceDesc.ServiceName, health.Postgres)
 
 	return nil
 }
 
-func RegisterViaServer(server *grpcsrv.Server, srv *ViaServer, _ fx.Lifecycle) error {
-	impb.RegisterViasServer(server.Server, srv)
+func RegisterPrivacyRuleServer(server *grpcsrv.Server, srv *PrivacyRuleServer, _ fx.Lifecycle) error {
+	impb.RegisterPrivacyRulesServer(server, srv)
+	server.Health().Watch(impb.PrivacyRules_ServiceDesc.ServiceName, health.Postgres)
 
 	return nil
 }
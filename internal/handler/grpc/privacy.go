@@ -3,6 +3,12 @@ package grpc
 import (
 	"context"
 	"log/slog"
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
 
 	impb "github.com/webitel/im-contact-service/gen/go/contact/v1"
 	"github.com/webitel/im-contact-service/internal/handler/grpc/mapper"
@@ -20,8 +26,10 @@ type ContactPrivacyServer struct {
 }
 
 type PrivacyService interface {
-	CanSend(context.Context, *model.CanSendRequest) error
-	CanInvite(context.Context, *model.CanInviteRequest) error
+	CanSend(context.Context, *model.CanSendRequest) (*model.PrivacyDecision, error)
+	CanInvite(context.Context, *model.CanInviteRequest) (*model.PrivacyDecision, error)
+	BatchCanSend(context.Context, *model.BatchCanSendRequest) ([]*model.PrivacyDecision, error)
+	BatchCanInvite(context.Context, *model.BatchCanInviteRequest) ([]*model.PrivacyDecision, error)
 }
 
 func NewPrivacyServer(handler service.ContactPrivacyService, logger *slog.Logger) *ContactPrivacyServer {
@@ -34,12 +42,16 @@ func (c *ContactPrivacyServer) CanSend(ctx context.Context, request *impb.CanSen
 		return nil, err
 	}
 
-	err = c.handler.CanSend(ctx, converted)
+	decision, err := c.handler.CanSend(ctx, converted)
 	if err != nil {
 		return nil, err
 	}
 
-	return &impb.CanSendResponse{}, nil
+	if !decision.Allowed {
+		return nil, newDenialError(decision)
+	}
+
+	return &impb.CanSendResponse{Allowed: true}, nil
 }
 
 func (c *ContactPrivacyServer) CanInvite(ctx context.Context, request *impb.CanInviteRequest) (*impb.CanInviteResponse, error) {
@@ -48,10 +60,122 @@ func (c *ContactPrivacyServer) CanInvite(ctx context.Context, request *impb.CanI
 		return nil, err
 	}
 
-	err = c.handler.CanInvite(ctx, converted)
+	decision, err := c.handler.CanInvite(ctx, converted)
+	if err != nil {
+		return nil, err
+	}
+
+	if !decision.Allowed {
+		return nil, newDenialError(decision)
+	}
+
+	return &impb.CanInviteResponse{Allowed: true}, nil
+}
+
+func (c *ContactPrivacyServer) BatchCanSend(ctx context.Context, request *impb.BatchCanSendRequest) (*impb.BatchCanSendResponse, error) {
+	converted, err := c.inMapper.ConvertBatchCanSendRequest(request)
 	if err != nil {
 		return nil, err
 	}
 
-	return &impb.CanInviteResponse{}, nil
+	decisions, err := c.handler.BatchCanSend(ctx, converted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.BatchCanSendResponse{Decisions: marshalPrivacyDecisions(decisions)}, nil
+}
+
+func (c *ContactPrivacyServer) BatchCanInvite(ctx context.Context, request *impb.BatchCanInviteRequest) (*impb.BatchCanInviteResponse, error) {
+	converted, err := c.inMapper.ConvertBatchCanInviteRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions, err := c.handler.BatchCanInvite(ctx, converted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.BatchCanInviteResponse{Decisions: marshalPrivacyDecisions(decisions)}, nil
+}
+
+func marshalPrivacyDecisions(decisions []*model.PrivacyDecision) []*impb.PrivacyDecision {
+	out := make([]*impb.PrivacyDecision, 0, len(decisions))
+	for _, decision := range decisions {
+		out = append(out, &impb.PrivacyDecision{
+			To:           decision.To.String(),
+			Allowed:      decision.Allowed,
+			Reason:       marshalDenialReason(decision.Reason),
+			Message:      denialMessage(decision),
+			RetryAfterMs: decision.RetryAfter.Milliseconds(),
+		})
+	}
+
+	return out
+}
+
+func marshalDenialReason(reason model.DenialReason) impb.DenialReason {
+	switch reason {
+	case model.DenialReasonDifferentDomain:
+		return impb.DenialReason_DENIAL_REASON_DIFFERENT_DOMAIN
+	case model.DenialReasonBotToBot:
+		return impb.DenialReason_DENIAL_REASON_BOT_TO_BOT
+	case model.DenialReasonPrivacySettings:
+		return impb.DenialReason_DENIAL_REASON_PRIVACY_SETTINGS
+	case model.DenialReasonContactNotFound:
+		return impb.DenialReason_DENIAL_REASON_CONTACT_NOT_FOUND
+	case model.DenialReasonCustomRule:
+		return impb.DenialReason_DENIAL_REASON_CUSTOM_RULE
+	case model.DenialReasonRateLimited:
+		return impb.DenialReason_DENIAL_REASON_RATE_LIMITED
+	default:
+		return impb.DenialReason_DENIAL_REASON_UNSPECIFIED
+	}
+}
+
+// denialError is the error of a denied single check. It keeps the status of the cause, e.g. PermissionDenied,
+// so that callers treating "no error" as "permitted" keep working, and tells the reason in google.rpc.ErrorInfo.
+type denialError struct {
+	cause   error
+	details *errdetails.ErrorInfo
+}
+
+func newDenialError(decision *model.PrivacyDecision) error {
+	cause := decision.Cause
+	if cause == nil {
+		cause = errors.Forbidden("denied by privacy checks")
+	}
+
+	info := &errdetails.ErrorInfo{
+		Reason: marshalDenialReason(decision.Reason).String(),
+		Domain: impb.ContactPrivacy_ServiceDesc.ServiceName,
+	}
+
+	if decision.RetryAfter > 0 {
+		info.Metadata = map[string]string{"retry_after_ms": strconv.FormatInt(decision.RetryAfter.Milliseconds(), 10)}
+	}
+
+	return &denialError{cause: cause, details: info}
+}
+
+func (e *denialError) Error() string {
+	return e.cause.Error()
+}
+
+func (e *denialError) Unwrap() error {
+	return e.cause
+}
+
+// ErrorDetails are put into the status by the server error interceptor.
+func (e *denialError) ErrorDetails() []protoadapt.MessageV1 {
+	return []protoadapt.MessageV1{e.details}
+}
+
+func denialMessage(decision *model.PrivacyDecision) string {
+	if decision.Cause == nil {
+		return ""
+	}
+
+	return decision.Cause.Error()
 }
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	impb "github.com/webitel/im-contact-service/gen/go/contact/v1"
+	"github.com/webitel/im-contact-service/internal/handler/grpc/mapper"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/service"
+	"github.com/webitel/im-contact-service/internal/utils"
+)
+
+var _ impb.PrivacyRulesServer = &PrivacyRuleServer{}
+
+type PrivacyRuleServer struct {
+	impb.UnimplementedPrivacyRulesServer
+
+	service service.PrivacyRuleService
+}
+
+func NewPrivacyRuleServer(handler service.PrivacyRuleService) *PrivacyRuleServer {
+	return &PrivacyRuleServer{service: handler}
+}
+
+// Create implements [contact.PrivacyRulesServer].
+func (s *PrivacyRuleServer) Create(ctx context.Context, req *impb.CreatePrivacyRuleRequest) (*impb.PrivacyRule, error) {
+	rule, err := s.service.Create(ctx, &model.PrivacyRule{
+		DomainID:   int(req.GetDomainId()),
+		Name:       req.GetName(),
+		Expression: req.GetExpression(),
+		Scope:      model.PrivacyRuleScope(req.GetScope()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalPrivacyRule(rule), nil
+}
+
+// List implements [contact.PrivacyRulesServer].
+func (s *PrivacyRuleServer) List(ctx context.Context, req *impb.ListPrivacyRulesRequest) (*impb.PrivacyRuleList, error) {
+	rules, err := s.service.List(ctx, int(req.GetDomainId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.PrivacyRuleList{Items: utils.Map(rules, marshalPrivacyRule)}, nil
+}
+
+// Delete implements [contact.PrivacyRulesServer].
+func (s *PrivacyRuleServer) Delete(ctx context.Context, req *impb.DeletePrivacyRuleRequest) (*impb.DeletePrivacyRuleResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, errors.InvalidArgument("invalid privacy rule id", errors.WithCause(err))
+	}
+
+	if err := s.service.Delete(ctx, int(req.GetDomainId()), id); err != nil {
+		return nil, err
+	}
+
+	return &impb.DeletePrivacyRuleResponse{}, nil
+}
+
+func marshalPrivacyRule(rule *model.PrivacyRule) *impb.PrivacyRule {
+	return &impb.PrivacyRule{
+		Id:         rule.ID.String(),
+		DomainId:   int32(rule.DomainID),
+		Name:       rule.Name,
+		Expression: rule.Expression,
+		Scope:      impb.PrivacyRuleScope(rule.Scope),
+		CreatedAt:  mapper.ConvertTimeToInt64(rule.CreatedAt),
+	}
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/protoadapt"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	impb "github.com/webitel/im-contact-service/gen/go/contact/v1"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/service"
+)
+
+type fakePrivacyService struct {
+	service.ContactPrivacyService
+
+	decision *model.PrivacyDecision
+}
+
+func (f *fakePrivacyService) CanSend(context.Context, *model.CanSendRequest) (*model.PrivacyDecision, error) {
+	return f.decision, nil
+}
+
+func (f *fakePrivacyService) CanInvite(context.Context, *model.CanInviteRequest) (*model.PrivacyDecision, error) {
+	return f.decision, nil
+}
+
+func errorInfo(t *testing.T, err error) *errdetails.ErrorInfo {
+	t.Helper()
+
+	var detailed interface{ ErrorDetails() []protoadapt.MessageV1 }
+	if !errors.As(err, &detailed) {
+		t.Fatalf("error %v carries no details", err)
+	}
+
+	info, ok := detailed.ErrorDetails()[0].(*errdetails.ErrorInfo)
+	if !ok {
+		t.Fatalf("error %v carries no ErrorInfo", err)
+	}
+
+	return info
+}
+
+func TestPrivacyDenialsAreErrors(t *testing.T) {
+	fake := &fakePrivacyService{}
+	server := NewPrivacyServer(fake, slog.New(slog.DiscardHandler))
+	request := &impb.CanSendRequest{From: uuid.NewString(), To: uuid.NewString()}
+
+	fake.decision = &model.PrivacyDecision{Allowed: true}
+
+	res, err := server.CanSend(context.Background(), request)
+	if err != nil || !res.GetAllowed() {
+		t.Fatalf("allowed: res = %v, err = %v", res, err)
+	}
+
+	fake.decision = &model.PrivacyDecision{
+		Reason: model.DenialReasonPrivacySettings,
+		Cause:  model.NewDenialError(model.DenialReasonPrivacySettings, errors.Forbidden("receiver privacy settings forbid sending messages")),
+	}
+
+	_, err = server.CanSend(context.Background(), request)
+	if errors.Code(err) != codes.PermissionDenied {
+		t.Fatalf("denied: err = %v, want PermissionDenied", err)
+	}
+
+	if info := errorInfo(t, err); info.GetReason() != impb.DenialReason_DENIAL_REASON_PRIVACY_SETTINGS.String() {
+		t.Fatalf("denied: reason = %q", info.GetReason())
+	}
+
+	fake.decision = &model.PrivacyDecision{
+		Reason:     model.DenialReasonRateLimited,
+		Cause:      model.NewDenialError(model.DenialReasonRateLimited, errors.New("invite rate limit exceeded", errors.WithCode(codes.ResourceExhausted))),
+		RetryAfter: 1500 * time.Millisecond,
+	}
+
+	_, err = server.CanInvite(context.Background(), &impb.CanInviteRequest{From: request.From, To: request.To})
+	if errors.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("rate limited: err = %v, want ResourceExhausted", err)
+	}
+
+	if info := errorInfo(t, err); info.GetMetadata()["retry_after_ms"] != "1500" {
+		t.Fatalf("rate limited: metadata = %v", info.GetMetadata())
+	}
+}
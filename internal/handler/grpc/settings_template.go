@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+
+	impb "github.com/webitel/im-contact-service/gen/go/contact/v1"
+	"github.com/webitel/im-contact-service/internal/handler/grpc/mapper"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/service"
+)
+
+var _ impb.SettingsTemplatesServer = &SettingsTemplateServer{}
+
+type SettingsTemplateServer struct {
+	impb.UnimplementedSettingsTemplatesServer
+
+	service service.SettingsTemplateService
+}
+
+func NewSettingsTemplateServer(handler service.SettingsTemplateService) *SettingsTemplateServer {
+	return &SettingsTemplateServer{service: handler}
+}
+
+// Get implements [contact.SettingsTemplatesServer].
+func (s *SettingsTemplateServer) Get(ctx context.Context, req *impb.GetSettingsTemplateRequest) (*impb.SettingsTemplate, error) {
+	template, err := s.service.Get(ctx, int(req.GetDomainId()))
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalSettingsTemplate(template), nil
+}
+
+// Set implements [contact.SettingsTemplatesServer].
+func (s *SettingsTemplateServer) Set(ctx context.Context, req *impb.SetSettingsTemplateRequest) (*impb.SettingsTemplate, error) {
+	template, err := s.service.Set(ctx, &model.SettingsTemplate{
+		DomainID:          int(req.GetDomainId()),
+		AllowInvitesFrom:  mapper.ConvertInUserFilter(req.GetAllowInvitesFrom()),
+		AllowMessagesFrom: mapper.ConvertInUserFilter(req.GetAllowMessagesFrom()),
+		Discoverable:      req.GetDiscoverable(),
+		LockedFields:      req.GetLockedFields(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return marshalSettingsTemplate(template), nil
+}
+
+// Delete implements [contact.SettingsTemplatesServer].
+func (s *SettingsTemplateServer) Delete(ctx context.Context, req *impb.DeleteSettingsTemplateRequest) (*impb.DeleteSettingsTemplateResponse, error) {
+	if err := s.service.Delete(ctx, int(req.GetDomainId())); err != nil {
+		return nil, err
+	}
+
+	return &impb.DeleteSettingsTemplateResponse{}, nil
+}
+
+func marshalSettingsTemplate(template *model.SettingsTemplate) *impb.SettingsTemplate {
+	return &impb.SettingsTemplate{
+		DomainId:          int32(template.DomainID),
+		AllowInvitesFrom:  mapper.ConvertOutUserFilter(template.AllowInvitesFrom),
+		AllowMessagesFrom: mapper.ConvertOutUserFilter(template.AllowMessagesFrom),
+		Discoverable:      template.Discoverable,
+		LockedFields:      template.LockedFields,
+		UpdatedAt:         mapper.ConvertTimeToInt64(template.UpdatedAt),
+	}
+}
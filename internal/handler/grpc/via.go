@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -9,6 +10,7 @@ import (
 	"github.com/webitel/webitel-go-kit/pkg/errors"
 
 	impb "github.com/webitel/im-contact-service/gen/go/contact/v1"
+	"github.com/webitel/im-contact-service/internal/handler/grpc/mapper"
 	"github.com/webitel/im-contact-service/internal/model"
 	"github.com/webitel/im-contact-service/internal/service"
 )
@@ -43,6 +45,9 @@ func (viaServer *ViaServer) Create(ctx context.Context, req *impb.CreateViaReque
 		Metadata:      req.GetMetadata().AsMap(),
 		Iss:           req.GetIss(),
 		Sub:           req.GetSub(),
+		Kind:          mapper.ConvertInViaKind(req.GetKind()),
+		Priority:      int(req.GetPriority()),
+		DisabledUntil: mapper.ConvertInt64ToOptionalTime(req.GetDisabledUntil()),
 	}
 
 	created, err := viaServer.via.Create(ctx, via)
@@ -70,6 +75,9 @@ func (viaServer *ViaServer) Update(ctx context.Context, req *impb.UpdateViaReque
 		Disable:       req.GetDisable(),
 		DisableReason: req.DisableReason,
 		Metadata:      req.GetMetadata().AsMap(),
+		Kind:          mapper.ConvertInViaKind(req.GetKind()),
+		Priority:      int(req.GetPriority()),
+		DisabledUntil: mapper.ConvertInt64ToOptionalTime(req.GetDisabledUntil()),
 	}
 
 	updated, err := viaServer.via.Update(ctx, update)
@@ -104,6 +112,9 @@ func (viaServer *ViaServer) PartialUpdate(ctx context.Context, req *impb.Partial
 			Disable:       req.GetUpdate().GetDisable(),
 			DisableReason: req.GetUpdate().DisableReason,
 			Metadata:      req.GetUpdate().GetMetadata().AsMap(),
+			Kind:          mapper.ConvertInViaKind(req.GetUpdate().GetKind()),
+			Priority:      int(req.GetUpdate().GetPriority()),
+			DisabledUntil: mapper.ConvertInt64ToOptionalTime(req.GetUpdate().GetDisabledUntil()),
 		},
 		Fields: req.GetFieldMask().GetPaths(),
 	}
@@ -137,6 +148,9 @@ func (viaServer *ViaServer) Search(ctx context.Context, req *impb.SearchViaReque
 		ContactIDs: contactIDs,
 		Disabled:   req.Disabled,
 		Vias:       req.GetVias(),
+		Verified:   req.Verified,
+		Kinds:      mapper.ConvertInViaKinds(req.GetKinds()),
+		Consent:    convertConsentFilter(req.GetConsent()),
 	}
 
 	vias, err := viaServer.via.Search(ctx, filter)
@@ -159,6 +173,227 @@ func (viaServer *ViaServer) Search(ctx context.Context, req *impb.SearchViaReque
 	return response, nil
 }
 
+func (viaServer *ViaServer) Delete(ctx context.Context, req *impb.DeleteViaRequest) (*impb.Via, error) {
+	contactID, err := uuid.Parse(req.GetContactId())
+	if err != nil {
+		return nil, errors.InvalidArgument("contact id has invalid uuid format", errors.WithCause(err), errors.WithID("grpc.via.delete"))
+	}
+
+	deleted, err := viaServer.via.Delete(ctx, &model.DeleteViaCommand{ContactID: contactID, Via: req.GetVia()})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := convertDomainToProto(deleted)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.WithID("grpc.via.delete"))
+	}
+
+	return response, nil
+}
+
+func (viaServer *ViaServer) DeleteByContact(ctx context.Context, req *impb.DeleteViasByContactRequest) (*impb.DeleteViasByContactResponse, error) {
+	contactID, err := uuid.Parse(req.GetContactId())
+	if err != nil {
+		return nil, errors.InvalidArgument("contact id has invalid uuid format", errors.WithCause(err), errors.WithID("grpc.via.delete_by_contact"))
+	}
+
+	deleted, err := viaServer.via.DeleteByContact(ctx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	protoVias, err := convertDomainViaListToProto(deleted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.DeleteViasByContactResponse{Items: protoVias}, nil
+}
+
+func (viaServer *ViaServer) Replace(ctx context.Context, req *impb.ReplaceViasRequest) (*impb.ReplaceViasResponse, error) {
+	contactID, err := uuid.Parse(req.GetContactId())
+	if err != nil {
+		return nil, errors.InvalidArgument("contact id has invalid uuid format", errors.WithCause(err), errors.WithID("grpc.via.replace"))
+	}
+
+	items := make([]*model.ReplaceViaItem, 0, len(req.GetItems()))
+	for _, item := range req.GetItems() {
+		items = append(items, &model.ReplaceViaItem{
+			Via:           item.GetVia(),
+			Kind:          mapper.ConvertInViaKind(item.GetKind()),
+			Disable:       item.GetDisable(),
+			DisableReason: item.DisableReason,
+			Metadata:      item.GetMetadata().AsMap(),
+			Priority:      int(item.GetPriority()),
+			DisabledUntil: mapper.ConvertInt64ToOptionalTime(item.GetDisabledUntil()),
+		})
+	}
+
+	vias, err := viaServer.via.Replace(ctx, &model.ReplaceViasCommand{ContactID: contactID, Items: items})
+	if err != nil {
+		return nil, err
+	}
+
+	protoVias, err := convertDomainViaListToProto(vias)
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.ReplaceViasResponse{Items: protoVias}, nil
+}
+
+func (viaServer *ViaServer) StartVerification(ctx context.Context, req *impb.StartViaVerificationRequest) (*impb.StartViaVerificationResponse, error) {
+	contactID, err := uuid.Parse(req.GetContactId())
+	if err != nil {
+		return nil, errors.InvalidArgument("contact id has invalid uuid format", errors.WithCause(err), errors.WithID("grpc.via.start_verification"))
+	}
+
+	verification, err := viaServer.via.StartVerification(ctx, &model.StartViaVerificationCommand{ContactID: contactID, Via: req.GetVia()})
+	if err != nil {
+		return nil, err
+	}
+
+	return &impb.StartViaVerificationResponse{
+		ExpiresAt:   verification.ExpiresAt.UTC().UnixMilli(),
+		MaxAttempts: int32(verification.MaxAttempts),
+	}, nil
+}
+
+func (viaServer *ViaServer) ConfirmVerification(ctx context.Context, req *impb.ConfirmViaVerificationRequest) (*impb.Via, error) {
+	contactID, err := uuid.Parse(req.GetContactId())
+	if err != nil {
+		return nil, errors.InvalidArgument("contact id has invalid uuid format", errors.WithCause(err), errors.WithID("grpc.via.confirm_verification"))
+	}
+
+	verified, err := viaServer.via.ConfirmVerification(ctx, &model.ConfirmViaVerificationCommand{
+		ContactID: contactID,
+		Via:       req.GetVia(),
+		Code:      req.GetCode(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := convertDomainToProto(verified)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.WithID("grpc.via.confirm_verification"))
+	}
+
+	return response, nil
+}
+
+func (viaServer *ViaServer) SetPrimaryVia(ctx context.Context, req *impb.SetPrimaryViaRequest) (*impb.Via, error) {
+	contactID, err := uuid.Parse(req.GetContactId())
+	if err != nil {
+		return nil, errors.InvalidArgument("contact id has invalid uuid format", errors.WithCause(err), errors.WithID("grpc.via.set_primary_via"))
+	}
+
+	primary, err := viaServer.via.SetPrimary(ctx, &model.SetPrimaryViaCommand{ContactID: contactID, Via: req.GetVia()})
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := convertDomainToProto(primary)
+	if err != nil {
+		return nil, errors.Wrap(err, errors.WithID("grpc.via.set_primary_via"))
+	}
+
+	return response, nil
+}
+
+func (viaServer *ViaServer) GrantConsent(ctx context.Context, req *impb.ChangeViaConsentRequest) (*impb.ViaConsent, error) {
+	return viaServer.changeConsent(ctx, req, model.ConsentStatusGranted)
+}
+
+func (viaServer *ViaServer) RevokeConsent(ctx context.Context, req *impb.ChangeViaConsentRequest) (*impb.ViaConsent, error) {
+	return viaServer.changeConsent(ctx, req, model.ConsentStatusRevoked)
+}
+
+func (viaServer *ViaServer) changeConsent(ctx context.Context, req *impb.ChangeViaConsentRequest, status model.ConsentStatus) (*impb.ViaConsent, error) {
+	contactID, err := uuid.Parse(req.GetContactId())
+	if err != nil {
+		return nil, errors.InvalidArgument("contact id has invalid uuid format", errors.WithCause(err), errors.WithID("grpc.via.change_consent"))
+	}
+
+	command := &model.ChangeViaConsentCommand{
+		ContactID:  contactID,
+		Via:        req.GetVia(),
+		Status:     status,
+		Source:     req.GetSource(),
+		LegalBasis: req.GetLegalBasis(),
+	}
+
+	if ms := req.GetOccurredAt(); ms > 0 {
+		command.OccurredAt = time.UnixMilli(ms)
+	}
+
+	recorded, err := viaServer.via.ChangeConsent(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertConsentToProto(recorded), nil
+}
+
+func (viaServer *ViaServer) GetConsent(ctx context.Context, req *impb.GetViaConsentRequest) (*impb.GetViaConsentResponse, error) {
+	contactID, err := uuid.Parse(req.GetContactId())
+	if err != nil {
+		return nil, errors.InvalidArgument("contact id has invalid uuid format", errors.WithCause(err), errors.WithID("grpc.via.get_consent"))
+	}
+
+	state, err := viaServer.via.GetConsent(ctx, &model.GetViaConsentRequest{
+		ContactID:      contactID,
+		Via:            req.GetVia(),
+		IncludeHistory: req.GetIncludeHistory(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &impb.GetViaConsentResponse{Current: convertConsentToProto(state.Current)}
+	for _, consent := range state.History {
+		response.History = append(response.History, convertConsentToProto(consent))
+	}
+
+	return response, nil
+}
+
+func convertConsentToProto(consent *model.ViaConsent) *impb.ViaConsent {
+	if consent == nil {
+		return nil
+	}
+
+	status := impb.ConsentStatus_CONSENT_STATUS_GRANTED
+	if consent.Status == model.ConsentStatusRevoked {
+		status = impb.ConsentStatus_CONSENT_STATUS_REVOKED
+	}
+
+	return &impb.ViaConsent{
+		Id:         consent.ID.String(),
+		ContactId:  consent.ContactID.String(),
+		Via:        consent.Via,
+		Status:     status,
+		Source:     consent.Source,
+		LegalBasis: consent.LegalBasis,
+		OccurredAt: consent.OccurredAt.UTC().UnixMilli(),
+		CreatedAt:  consent.CreatedAt.UTC().UnixMilli(),
+	}
+}
+
+func convertConsentFilter(filter impb.ConsentFilter) model.ConsentFilter {
+	switch filter {
+	case impb.ConsentFilter_CONSENT_FILTER_GRANTED:
+		return model.ConsentFilterGranted
+	case impb.ConsentFilter_CONSENT_FILTER_REVOKED:
+		return model.ConsentFilterRevoked
+	case impb.ConsentFilter_CONSENT_FILTER_UNKNOWN:
+		return model.ConsentFilterUnknown
+	default:
+		return model.ConsentFilterAny
+	}
+}
+
 func convertStringsToUUID(idStrs []string) (uuid.UUIDs, error) {
 	idStrsLen := len(idStrs)
 	if idStrsLen == 0 {
@@ -209,5 +444,14 @@ func convertDomainToProto(via *model.ViaCommunication) (*impb.Via, error) {
 		CreatedAt:     via.CreatedAtUTCUnix(),
 		UpdatedAt:     via.UpdatedAtUTCUnix(),
 		Metadata:      metadata,
+		VerifiedAt:    via.VerifiedAtUTCUnix(),
+		Kind:          mapper.ConvertOutViaKind(via.Kind),
+		Primary:       via.Primary,
+		Priority:      int32(via.Priority),
+		FailureCount:  int32(via.FailureCount),
+		LastFailureAt: via.LastFailureAtUTCUnix(),
+		DisabledUntil: via.DisabledUntilUTCUnix(),
+		CreatedBy:     int64(via.CreatedBy),
+		UpdatedBy:     int64(via.UpdatedBy),
 	}, nil
 }
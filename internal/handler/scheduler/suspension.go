@@ -0,0 +1,85 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/webitel/im-contact-service/config"
+)
+
+const defaultSuspensionBatchSize = 100
+
+type SuspensionHandler interface {
+	// ReenableExpired enables up to limit vias whose suspension ended and reports how many it enabled.
+	ReenableExpired(ctx context.Context, limit int) (int, error)
+}
+
+// RunSuspensionScheduler periodically enables vias whose suspension ended.
+// Each tick drains every expired suspension batch by batch. A non-positive interval turns the scheduler off.
+func RunSuspensionScheduler(lc fx.Lifecycle, cfg *config.Config, logger *slog.Logger, h SuspensionHandler) {
+	interval := cfg.Suspension.CheckInterval
+	if interval <= 0 {
+		return
+	}
+
+	batchSize := cfg.Suspension.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultSuspensionBatchSize
+	}
+
+	log := logger.With("component", "suspension_scheduler")
+
+	var (
+		wg     sync.WaitGroup
+		cancel context.CancelFunc
+	)
+
+	lc.Append(fx.Hook{
+		OnStart: func(_ context.Context) error {
+			var ctx context.Context
+
+			ctx, cancel = context.WithCancel(context.Background())
+
+			wg.Go(func() {
+				ticker := time.NewTicker(interval)
+				defer ticker.Stop()
+
+				for {
+					select {
+					case <-ctx.Done():
+						return
+					case <-ticker.C:
+						reenableExpired(ctx, h, batchSize, log)
+					}
+				}
+			})
+
+			return nil
+		},
+		OnStop: func(_ context.Context) error {
+			cancel()
+			wg.Wait()
+
+			return nil
+		},
+	})
+}
+
+func reenableExpired(ctx context.Context, h SuspensionHandler, batchSize int, log *slog.Logger) {
+	for ctx.Err() == nil {
+		n, err := h.ReenableExpired(ctx, batchSize)
+		if err != nil {
+			log.Error("reenabling expired suspensions", "error", err)
+
+			return
+		}
+
+		if n < batchSize {
+			return
+		}
+	}
+}
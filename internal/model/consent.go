@@ -0,0 +1,130 @@
+package model
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+const (
+	MaxCharactersInConsentSource     = 255
+	MaxCharactersInConsentLegalBasis = 64
+
+	// consentClockSkew is how far in the future a client supplied occurred_at may be.
+	consentClockSkew = time.Minute
+)
+
+type ConsentStatus string
+
+const (
+	ConsentStatusGranted ConsentStatus = "granted"
+	ConsentStatusRevoked ConsentStatus = "revoked"
+)
+
+// ConsentFilter narrows vias by their current marketing consent.
+type ConsentFilter string
+
+const (
+	ConsentFilterAny     ConsentFilter = ""
+	ConsentFilterGranted ConsentFilter = "granted"
+	ConsentFilterRevoked ConsentFilter = "revoked"
+	// ConsentFilterUnknown matches vias that never had consent recorded.
+	ConsentFilterUnknown ConsentFilter = "unknown"
+)
+
+// ViaConsent is one marketing consent change of a via. It is independent of the operational Disable flag.
+type ViaConsent struct {
+	ID         uuid.UUID     `db:"id"`
+	ContactID  uuid.UUID     `db:"contact_id"`
+	Via        string        `db:"via"`
+	Status     ConsentStatus `db:"status"`
+	Source     string        `db:"source"`
+	LegalBasis string        `db:"legal_basis"`
+	OccurredAt time.Time     `db:"occurred_at"`
+	CreatedAt  time.Time     `db:"created_at"`
+}
+
+type ChangeViaConsentCommand struct {
+	ContactID  uuid.UUID
+	Via        string
+	Status     ConsentStatus
+	Source     string
+	LegalBasis string
+	// OccurredAt is when the contact gave or withdrew consent; zero means now.
+	OccurredAt time.Time
+}
+
+func (c *ChangeViaConsentCommand) Validate() error {
+	if c == nil {
+		return errors.InvalidArgument("received nil pointer call for change consent command", errors.WithID("model.consent.validate"))
+	}
+
+	if c.ContactID == uuid.Nil {
+		return errors.InvalidArgument("contact id is required", errors.WithID("model.consent.validate"))
+	}
+
+	if strings.TrimSpace(c.Via) == "" {
+		return errors.InvalidArgument("via is required", errors.WithID("model.consent.validate"))
+	}
+
+	if c.Status != ConsentStatusGranted && c.Status != ConsentStatusRevoked {
+		return errors.InvalidArgument("unknown consent status: "+string(c.Status), errors.WithID("model.consent.validate"))
+	}
+
+	if source := strings.TrimSpace(c.Source); source == "" || utf8.RuneCountInString(source) > MaxCharactersInConsentSource {
+		return errors.InvalidArgument("consent source is required and limited to 255 characters", errors.WithID("model.consent.validate"))
+	}
+
+	if basis := strings.TrimSpace(c.LegalBasis); basis == "" || utf8.RuneCountInString(basis) > MaxCharactersInConsentLegalBasis {
+		return errors.InvalidArgument("consent legal basis is required and limited to 64 characters", errors.WithID("model.consent.validate"))
+	}
+
+	if c.OccurredAt.After(time.Now().Add(consentClockSkew)) {
+		return errors.InvalidArgument("consent can't occur in the future", errors.WithID("model.consent.validate"))
+	}
+
+	return nil
+}
+
+// Normalize trims the consent source and legal basis and fills OccurredAt.
+// Via is resolved to the stored key by the service.
+func (c *ChangeViaConsentCommand) Normalize() {
+	c.Source = strings.TrimSpace(c.Source)
+	c.LegalBasis = strings.TrimSpace(c.LegalBasis)
+
+	if c.OccurredAt.IsZero() {
+		c.OccurredAt = time.Now()
+	}
+}
+
+type GetViaConsentRequest struct {
+	ContactID      uuid.UUID
+	Via            string
+	IncludeHistory bool
+}
+
+func (r *GetViaConsentRequest) Validate() error {
+	if r == nil {
+		return errors.InvalidArgument("received nil pointer call for get consent request", errors.WithID("model.consent.validate"))
+	}
+
+	if r.ContactID == uuid.Nil {
+		return errors.InvalidArgument("contact id is required", errors.WithID("model.consent.validate"))
+	}
+
+	if strings.TrimSpace(r.Via) == "" {
+		return errors.InvalidArgument("via is required", errors.WithID("model.consent.validate"))
+	}
+
+	return nil
+}
+
+// ViaConsentState is the current consent of a via, nil when never recorded, with its history newest first.
+type ViaConsentState struct {
+	Current *ViaConsent
+	History []*ViaConsent
+}
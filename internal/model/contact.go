@@ -1,6 +1,8 @@
 package model
 
 import (
+	"strings"
+
 	"github.com/google/uuid"
 
 	"github.com/webitel/webitel-go-kit/pkg/errors"
@@ -42,14 +44,14 @@ func (c *Contact) Equal(compare *Contact) bool {
 func ContactAllowedFields() []string {
 	return []string{
 		"issuer_id", "application_id", "type", "name", "username", "metadata",
-		"id", "domain_id", "created_at", "updated_at", "subject_id", "is_bot", "via",
+		"id", "domain_id", "created_at", "updated_at", "created_by", "updated_by", "subject_id", "is_bot", "via",
 	}
 }
 
 func (c *Contact) DefaultFields() []string {
 	return []string{
 		"issuer_id", "application_id", "type", "name", "username", "metadata",
-		"id", "domain_id", "created_at", "updated_at", "subject_id", "is_bot",
+		"id", "domain_id", "created_at", "updated_at", "created_by", "updated_by", "subject_id", "is_bot",
 	}
 }
 
@@ -67,6 +69,11 @@ type ContactSearchRequest struct {
 	Subjects []string    `json:"subjects"`
 	OnlyBots *bool       `json:"is_bot"`
 	Via      string
+	// InitiatorContactID, when set, hides contacts that are not discoverable
+	// from everyone except themselves.
+	InitiatorContactID uuid.UUID
+	// CreatedBy limits the search to contacts created by the given users.
+	CreatedBy []int
 }
 
 type UpdateContactRequest struct {
@@ -76,6 +83,8 @@ type UpdateContactRequest struct {
 	Username *string           `json:"username"`
 	Metadata map[string]string `json:"metadata"`
 	Subject  string            `json:"subject"`
+	// UpdatedBy is the user making the change, set from the caller identity.
+	UpdatedBy int `json:"updated_by"`
 }
 
 type PartialUpdateContactRequest struct {
@@ -86,6 +95,8 @@ type PartialUpdateContactRequest struct {
 	Metadata map[string]string `json:"md"`
 	Subject  string            `json:"sub"`
 	Fields   []string
+	// UpdatedBy is the user making the change, set from the caller identity.
+	UpdatedBy int `json:"updated_by"`
 }
 
 type CanSendRequest struct {
@@ -133,3 +144,55 @@ func (l *LocateContactRequest) Validate() error {
 
 	return nil
 }
+
+// ResolveByViaRequest looks up the contacts owning a via. An empty Kind matches the via of any kind.
+type ResolveByViaRequest struct {
+	DomainID int
+	Kind     ViaKind
+	Value    string
+	// Values are the stored forms Value may have, set by Normalize.
+	Values []string
+}
+
+func (r *ResolveByViaRequest) Validate() error {
+	if r == nil {
+		return errors.InvalidArgument("received nil pointer call for resolve by via request", errors.WithID("model.contact.validate"))
+	}
+
+	if r.DomainID <= 0 {
+		return errors.InvalidArgument("domain id is required", errors.WithID("model.contact.validate"))
+	}
+
+	if strings.TrimSpace(r.Value) == "" {
+		return errors.InvalidArgument("via is required", errors.WithID("model.contact.validate"))
+	}
+
+	if r.Kind != "" && !r.Kind.Valid() {
+		return errors.InvalidArgument("unknown via kind: "+string(r.Kind), errors.WithID("model.contact.validate"))
+	}
+
+	return nil
+}
+
+// Normalize expands Value into the stored forms it may have, the same way vias are searched.
+// Without a kind the value as given is kept too, so vias stored under a kind other than the guessed one are found.
+func (r *ResolveByViaRequest) Normalize() error {
+	var kinds []ViaKind
+	if r.Kind != "" {
+		kinds = []ViaKind{r.Kind}
+	}
+
+	r.Values = ViaLookupValues(kinds, []string{r.Value})
+	if len(r.Values) == 0 {
+		return errors.InvalidArgument("via is not valid for the kind", errors.WithID("model.contact.normalize"))
+	}
+
+	return nil
+}
+
+// ResolvedContact is a contact found by one of its vias.
+type ResolvedContact struct {
+	Contact
+
+	MatchedVia *ViaCommunication `db:"matched_via"`
+}
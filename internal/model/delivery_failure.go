@@ -0,0 +1,64 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+// DisableReasonDeliveryFailures is the disable reason set when a via is disabled automatically
+// after crossing the delivery failure threshold.
+const DisableReasonDeliveryFailures = "delivery_failures_threshold"
+
+// DeliveryFailurePolicy defines when repeated delivery failures disable a via.
+// A zero DisableThreshold keeps counting failures but never disables the via.
+type DeliveryFailurePolicy struct {
+	DisableThreshold int
+}
+
+// RegisterDeliveryFailureCommand records one failed delivery to a via reported by the message pipeline.
+type RegisterDeliveryFailureCommand struct {
+	ContactID uuid.UUID
+	Via       string
+	Kind      ViaKind
+	Reason    string
+	// FailedAt is when the delivery failed; zero means now.
+	FailedAt time.Time
+}
+
+func (c *RegisterDeliveryFailureCommand) Validate() error {
+	if c == nil {
+		return errors.InvalidArgument("received nil pointer call for register delivery failure command", errors.WithID("model.delivery_failure.validate"))
+	}
+
+	if c.ContactID == uuid.Nil {
+		return errors.InvalidArgument("contact id is required", errors.WithID("model.delivery_failure.validate"))
+	}
+
+	if strings.TrimSpace(c.Via) == "" {
+		return errors.InvalidArgument("via is required", errors.WithID("model.delivery_failure.validate"))
+	}
+
+	if c.Kind != "" && !c.Kind.Valid() {
+		return errors.InvalidArgument("unknown via kind: "+string(c.Kind), errors.WithID("model.delivery_failure.validate"))
+	}
+
+	return nil
+}
+
+// Normalize defaults FailedAt to now. Via is resolved to the stored key by the service.
+func (c *RegisterDeliveryFailureCommand) Normalize() {
+	if c.FailedAt.IsZero() {
+		c.FailedAt = time.Now().UTC()
+	}
+}
+
+// DeliveryFailureResult holds the via after the failure was counted.
+// Disabled reports whether this failure crossed the threshold and disabled the via.
+type DeliveryFailureResult struct {
+	Via      *ViaCommunication
+	Disabled bool
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DenialReason int
+
+const (
+	DenialReasonUnspecified DenialReason = iota
+	DenialReasonDifferentDomain
+	DenialReasonBotToBot
+	DenialReasonPrivacySettings
+	DenialReasonContactNotFound
+	DenialReasonCustomRule
+	DenialReasonRateLimited
+)
+
+// DenialError is returned by privacy validators to tell which rule denied the request.
+type DenialError struct {
+	Reason DenialReason
+	Err    error
+}
+
+func NewDenialError(reason DenialReason, err error) *DenialError {
+	return &DenialError{Reason: reason, Err: err}
+}
+
+func (e *DenialError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DenialError) Unwrap() error {
+	return e.Err
+}
+
+// MaxPrivacyBatchSize bounds the recipients of a single batch check, so that one call can't load unbounded contact lists.
+const MaxPrivacyBatchSize = 1000
+
+type BatchCanSendRequest struct {
+	From uuid.UUID
+	To   []uuid.UUID
+}
+
+type BatchCanInviteRequest struct {
+	From uuid.UUID
+	To   []uuid.UUID
+}
+
+// PrivacyDecision is the outcome of a privacy check for a single recipient.
+// Reason and Cause are set when the recipient is not allowed.
+type PrivacyDecision struct {
+	To      uuid.UUID
+	Allowed bool
+	Reason  DenialReason
+	Cause   error
+	// RetryAfter is set when the sender is rate limited.
+	RetryAfter time.Duration
+}
+
+type PrivacyRuleScope int
+
+const (
+	PrivacyRuleScopeAll PrivacyRuleScope = iota
+	PrivacyRuleScopeSend
+	PrivacyRuleScopeInvite
+)
+
+func (s PrivacyRuleScope) Valid() bool {
+	return s >= PrivacyRuleScopeAll && s <= PrivacyRuleScopeInvite
+}
+
+// Covers reports whether a rule with scope s applies to checks of the given scope.
+func (s PrivacyRuleScope) Covers(check PrivacyRuleScope) bool {
+	return s == PrivacyRuleScopeAll || s == check
+}
+
+// PrivacyRule is a domain CEL expression that must evaluate to true for
+// a privacy check to pass.
+type PrivacyRule struct {
+	ID         uuid.UUID        `json:"id" db:"id"`
+	DomainID   int              `json:"domain_id" db:"domain_id"`
+	Name       string           `json:"name" db:"name"`
+	Expression string           `json:"expression" db:"expression"`
+	Scope      PrivacyRuleScope `json:"scope" db:"scope"`
+	CreatedAt  time.Time        `json:"created_at" db:"created_at"`
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -12,9 +13,20 @@ const (
 	All UserFilter = iota
 	Nobody
 	SameIssuer
+	SameApplication
+	NonBots
+	Allowlist
+	SameContactType
 )
 
-func (u *UserFilter) InFilter(from, to *Contact) bool {
+// Valid reports whether u is one of the known filters.
+func (u UserFilter) Valid() bool {
+	return u >= All && u <= SameContactType
+}
+
+// InFilter reports whether from passes the filter set by to.
+// allowlist is consulted only by the [Allowlist] filter.
+func (u *UserFilter) InFilter(from, to *Contact, allowlist []uuid.UUID) bool {
 	if from == nil || to == nil {
 		return false
 	}
@@ -28,16 +40,35 @@ func (u *UserFilter) InFilter(from, to *Contact) bool {
 		in = false
 	case SameIssuer:
 		in = from.IssuerID == to.IssuerID
+	case SameApplication:
+		in = from.ApplicationID == to.ApplicationID
+	case NonBots:
+		in = !from.IsBot
+	case Allowlist:
+		in = slices.Contains(allowlist, from.ID)
+	case SameContactType:
+		in = from.Type == to.Type
 	}
 
 	return in
 }
 
 type ContactSettings struct {
-	ID               uuid.UUID  `json:"id" db:"id"`
-	ContactID        uuid.UUID  `json:"contact_id" db:"contact_id"`
-	UpdatedAt        time.Time  `json:"updated_at" db:"updated_at"`
-	AllowInvitesFrom UserFilter `json:"allow_invites_from" db:"allow_invites_from"`
+	ID                uuid.UUID   `json:"id" db:"id"`
+	ContactID         uuid.UUID   `json:"contact_id" db:"contact_id"`
+	UpdatedAt         time.Time   `json:"updated_at" db:"updated_at"`
+	AllowInvitesFrom  UserFilter  `json:"allow_invites_from" db:"allow_invites_from"`
+	AllowMessagesFrom UserFilter  `json:"allow_messages_from" db:"allow_messages_from"`
+	AllowedContacts   []uuid.UUID `json:"allowed_contacts" db:"allowed_contacts"`
+	Discoverable      bool        `json:"discoverable" db:"discoverable"`
+	CreatedBy         int         `json:"created_by" db:"created_by"`
+	UpdatedBy         int         `json:"updated_by" db:"updated_by"`
+}
+
+// SettingsChange holds contact settings before and after a change made on the contact's behalf.
+type SettingsChange struct {
+	Old     *ContactSettings
+	Updated *ContactSettings
 }
 
 type GetContactSettingsRequest struct {
@@ -49,9 +80,67 @@ type UpdateContactSettingsRequest struct {
 	InitiatorContactID uuid.UUID
 	ContactID          uuid.UUID
 	AllowInvitesFrom   *UserFilter
+	AllowMessagesFrom  *UserFilter
+	// AllowedContacts replaces the allowlist when not nil.
+	AllowedContacts []uuid.UUID
+	Discoverable    *bool
+	// UpdatedBy is the user making the change, set from the caller identity.
+	UpdatedBy int
 }
 
 type CreateContactSettingsRequest struct {
 	ContactID uuid.UUID
 	Settings  *ContactSettings
+	// Discoverable is set when the caller chose a value; nil takes the domain template value,
+	// or true without a template, the same as settings created with a new contact. Settings.Discoverable is ignored.
+	Discoverable *bool
+	// CreatedBy is the user creating the settings, set from the caller identity.
+	CreatedBy int
+}
+
+// Settings fields a domain template can lock.
+const (
+	SettingsFieldAllowInvitesFrom  = "allow_invites_from"
+	SettingsFieldAllowMessagesFrom = "allow_messages_from"
+	SettingsFieldDiscoverable      = "discoverable"
+)
+
+func LockableSettingsFields() []string {
+	return []string{SettingsFieldAllowInvitesFrom, SettingsFieldAllowMessagesFrom, SettingsFieldDiscoverable}
+}
+
+// SettingsTemplate holds the settings applied to new contacts of a domain.
+// Locked fields cannot be changed by contacts.
+type SettingsTemplate struct {
+	DomainID          int        `json:"domain_id" db:"domain_id"`
+	AllowInvitesFrom  UserFilter `json:"allow_invites_from" db:"allow_invites_from"`
+	AllowMessagesFrom UserFilter `json:"allow_messages_from" db:"allow_messages_from"`
+	Discoverable      bool       `json:"discoverable" db:"discoverable"`
+	LockedFields      []string   `json:"locked_fields" db:"locked_fields"`
+	UpdatedAt         time.Time  `json:"updated_at" db:"updated_at"`
+}
+
+func (t *SettingsTemplate) IsLocked(field string) bool {
+	return t != nil && slices.Contains(t.LockedFields, field)
+}
+
+// CheckLocked returns the first locked field that the request changes to a value other than the template's.
+func (t *SettingsTemplate) CheckLocked(request *UpdateContactSettingsRequest) (string, bool) {
+	if t == nil || request == nil {
+		return "", false
+	}
+
+	if v := request.AllowInvitesFrom; v != nil && *v != t.AllowInvitesFrom && t.IsLocked(SettingsFieldAllowInvitesFrom) {
+		return SettingsFieldAllowInvitesFrom, true
+	}
+
+	if v := request.AllowMessagesFrom; v != nil && *v != t.AllowMessagesFrom && t.IsLocked(SettingsFieldAllowMessagesFrom) {
+		return SettingsFieldAllowMessagesFrom, true
+	}
+
+	if v := request.Discoverable; v != nil && *v != t.Discoverable && t.IsLocked(SettingsFieldDiscoverable) {
+		return SettingsFieldDiscoverable, true
+	}
+
+	return "", false
 }
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestUserFilterValid(t *testing.T) {
+	tests := []struct {
+		filter UserFilter
+		want   bool
+	}{
+		{All, true},
+		{Nobody, true},
+		{SameIssuer, true},
+		{SameApplication, true},
+		{NonBots, true},
+		{Allowlist, true},
+		{SameContactType, true},
+		{SameContactType + 1, false},
+		{-1, false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.filter.Valid(); got != tt.want {
+			t.Errorf("UserFilter(%d).Valid() = %v, want %v", tt.filter, got, tt.want)
+		}
+	}
+}
+
+func TestUserFilterInFilter(t *testing.T) {
+	listed := uuid.New()
+
+	to := &Contact{ApplicationID: "app", Type: "user"}
+
+	tests := []struct {
+		name   string
+		filter UserFilter
+		from   *Contact
+		want   bool
+	}{
+		{name: "all", filter: All, from: &Contact{}, want: true},
+		{name: "nobody", filter: Nobody, from: &Contact{}, want: false},
+		{name: "same application", filter: SameApplication, from: &Contact{ApplicationID: "app"}, want: true},
+		{name: "other application", filter: SameApplication, from: &Contact{ApplicationID: "other"}, want: false},
+		{name: "non bot", filter: NonBots, from: &Contact{}, want: true},
+		{name: "bot", filter: NonBots, from: &Contact{IsBot: true}, want: false},
+		{name: "allowlisted", filter: Allowlist, from: &Contact{BaseModel: BaseModel{ID: listed}}, want: true},
+		{name: "not allowlisted", filter: Allowlist, from: &Contact{BaseModel: BaseModel{ID: uuid.New()}}, want: false},
+		{name: "same contact type", filter: SameContactType, from: &Contact{Type: "user"}, want: true},
+		{name: "other contact type", filter: SameContactType, from: &Contact{Type: "bot"}, want: false},
+		{name: "unknown filter", filter: SameContactType + 1, from: &Contact{}, want: false},
+		{name: "missing sender", filter: All, from: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.InFilter(tt.from, to, []uuid.UUID{listed}); got != tt.want {
+				t.Fatalf("InFilter = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+const (
+	DefaultVerificationCodeTTL     = 10 * time.Minute
+	DefaultVerificationMaxAttempts = 5
+	DefaultVerificationCodeLength  = 6
+	DefaultVerificationResendAfter = time.Minute
+)
+
+// ViaVerification is a pending one-time code sent to a via. Only the salted hash of the code is kept.
+type ViaVerification struct {
+	ContactID   uuid.UUID `db:"contact_id"`
+	Via         string    `db:"via"`
+	CodeHash    []byte    `db:"code_hash"`
+	Salt        []byte    `db:"salt"`
+	Attempts    int       `db:"attempts"`
+	MaxAttempts int       `db:"max_attempts"`
+	ExpiresAt   time.Time `db:"expires_at"`
+	CreatedAt   time.Time `db:"created_at"`
+}
+
+func (v *ViaVerification) Expired(now time.Time) bool {
+	return !now.Before(v.ExpiresAt)
+}
+
+func (v *ViaVerification) Exhausted() bool {
+	return v.Attempts >= v.MaxAttempts
+}
+
+func (v *ViaVerification) AttemptsLeft() int {
+	return max(v.MaxAttempts-v.Attempts, 0)
+}
+
+// VerificationPolicy defines how verification codes are generated and how long they stay valid.
+type VerificationPolicy struct {
+	CodeTTL     time.Duration
+	MaxAttempts int
+	CodeLength  int
+	// ResendAfter is the time before a new code may replace the pending one,
+	// which bounds the guesses per via to MaxAttempts per ResendAfter.
+	ResendAfter time.Duration
+}
+
+// WithDefaults fills zero values with the package defaults.
+func (p VerificationPolicy) WithDefaults() VerificationPolicy {
+	if p.CodeTTL <= 0 {
+		p.CodeTTL = DefaultVerificationCodeTTL
+	}
+
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = DefaultVerificationMaxAttempts
+	}
+
+	if p.CodeLength <= 0 {
+		p.CodeLength = DefaultVerificationCodeLength
+	}
+
+	if p.ResendAfter <= 0 {
+		p.ResendAfter = DefaultVerificationResendAfter
+	}
+
+	return p
+}
+
+type StartViaVerificationCommand struct {
+	ContactID uuid.UUID
+	Via       string
+}
+
+func (c *StartViaVerificationCommand) Validate() error {
+	if c == nil {
+		return errors.InvalidArgument("received nil pointer call for start verification command", errors.WithID("model.verification.validate"))
+	}
+
+	if c.ContactID == uuid.Nil {
+		return errors.InvalidArgument("contact id is required", errors.WithID("model.verification.validate"))
+	}
+
+	if strings.Trim(c.Via, " ") == "" {
+		return errors.InvalidArgument("via is required", errors.WithID("model.verification.validate"))
+	}
+
+	return nil
+}
+
+type ConfirmViaVerificationCommand struct {
+	ContactID uuid.UUID
+	Via       string
+	Code      string
+}
+
+func (c *ConfirmViaVerificationCommand) Validate() error {
+	if c == nil {
+		return errors.InvalidArgument("received nil pointer call for confirm verification command", errors.WithID("model.verification.validate"))
+	}
+
+	if c.ContactID == uuid.Nil {
+		return errors.InvalidArgument("contact id is required", errors.WithID("model.verification.validate"))
+	}
+
+	if strings.Trim(c.Via, " ") == "" {
+		return errors.InvalidArgument("via is required", errors.WithID("model.verification.validate"))
+	}
+
+	if c.Code == "" {
+		return errors.InvalidArgument("verification code is required", errors.WithID("model.verification.validate"))
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -13,6 +14,8 @@ import (
 
 const MaxCharactersInDisableReason int = 255
 
+// ViaCommunication is a channel the contact can be reached by.
+// Primary marks the via to use first among the contact vias of the same kind; Priority orders the rest, lower first.
 type ViaCommunication struct {
 	ContactID     uuid.UUID      `db:"contact_id" json:"contact_id"`
 	Via           string         `db:"via" json:"via"`
@@ -21,6 +24,18 @@ type ViaCommunication struct {
 	CreatedAt     time.Time      `db:"created_at" json:"created_at"`
 	UpdatedAt     time.Time      `db:"updated_at" json:"updated_at"`
 	Metadata      map[string]any `db:"metadata" json:"metadata"`
+	VerifiedAt    *time.Time     `db:"verified_at" json:"verified_at"`
+	Kind          ViaKind        `db:"kind" json:"kind"`
+	Primary       bool           `db:"is_primary" json:"is_primary"`
+	Priority      int            `db:"priority" json:"priority"`
+	DomainID      int            `db:"domain_id" json:"domain_id,omitempty"`
+	FailureCount  int            `db:"failure_count" json:"failure_count"`
+	LastFailureAt *time.Time     `db:"last_failure_at" json:"last_failure_at"`
+	// DisabledUntil suspends a disabled via until the given time, after which it is enabled again.
+	DisabledUntil *time.Time `db:"disabled_until" json:"disabled_until"`
+	// CreatedBy and UpdatedBy are the users that created and last changed the via, 0 for services.
+	CreatedBy int `db:"created_by" json:"created_by"`
+	UpdatedBy int `db:"updated_by" json:"updated_by"`
 }
 
 func (communication *ViaCommunication) CreatedAtUTCUnix() int64 {
@@ -39,6 +54,30 @@ func (communication *ViaCommunication) UpdatedAtUTCUnix() int64 {
 	return max(communication.UpdatedAt.UTC().UnixMilli(), 0)
 }
 
+func (communication *ViaCommunication) VerifiedAtUTCUnix() int64 {
+	if communication == nil || communication.VerifiedAt == nil {
+		return 0
+	}
+
+	return max(communication.VerifiedAt.UTC().UnixMilli(), 0)
+}
+
+func (communication *ViaCommunication) LastFailureAtUTCUnix() int64 {
+	if communication == nil || communication.LastFailureAt == nil {
+		return 0
+	}
+
+	return max(communication.LastFailureAt.UTC().UnixMilli(), 0)
+}
+
+func (communication *ViaCommunication) DisabledUntilUTCUnix() int64 {
+	if communication == nil || communication.DisabledUntil == nil {
+		return 0
+	}
+
+	return max(communication.DisabledUntil.UTC().UnixMilli(), 0)
+}
+
 func (communication *ViaCommunication) Validate() error {
 	if communication == nil {
 		return errors.InvalidArgument("received nil pointer communication call", errors.WithID("model.communication.validate"))
@@ -58,20 +97,39 @@ func (communication *ViaCommunication) Validate() error {
 		)
 	}
 
+	return validateDisabledUntil(communication.Disable, communication.DisabledUntil)
+}
+
+// validateDisabledUntil checks that a suspension is set on a disabled via and ends in the future.
+func validateDisabledUntil(disable bool, disabledUntil *time.Time) error {
+	if disabledUntil == nil {
+		return nil
+	}
+
+	if !disable {
+		return errors.InvalidArgument("disabled until requires the via to be disabled", errors.WithID("model.communication.validate"))
+	}
+
+	if !disabledUntil.After(time.Now()) {
+		return errors.InvalidArgument("disabled until must be in the future", errors.WithID("model.communication.validate"))
+	}
+
 	return nil
 }
 
 func (communication *ViaCommunication) AvailableFields() []string {
-	return []string{"contact_id", "via", "disable", "disable_reason", "created_at", "updated_at", "metadata"}
+	return []string{"contact_id", "via", "disable", "disable_reason", "created_at", "updated_at", "metadata", "verified_at", "kind", "is_primary", "priority", "failure_count", "last_failure_at", "disabled_until", "created_by", "updated_by"}
 }
 
 func (communication *ViaCommunication) DefaultFields() []string {
-	return []string{"contact_id", "via", "disable", "disable_reason", "created_at", "updated_at", "metadata"}
+	return []string{"contact_id", "via", "disable", "disable_reason", "created_at", "updated_at", "metadata", "verified_at", "kind", "is_primary", "priority", "failure_count", "last_failure_at", "disabled_until", "created_by", "updated_by"}
 }
 
 func (communication *ViaCommunication) TableName() string { return "im_contact.via" }
 
 type SearchViaCommunicationsFilter struct {
+	// DomainID limits the search to vias of the domain contacts, 0 searches every domain.
+	DomainID   int
 	Sort       string
 	Limit      int
 	Page       int
@@ -79,6 +137,9 @@ type SearchViaCommunicationsFilter struct {
 	ContactIDs []uuid.UUID
 	Vias       []string
 	Disabled   *bool
+	Verified   *bool
+	Kinds      []ViaKind
+	Consent    ConsentFilter
 }
 
 func (searchCommunicationsFilter *SearchViaCommunicationsFilter) Validate() error {
@@ -86,9 +147,30 @@ func (searchCommunicationsFilter *SearchViaCommunicationsFilter) Validate() erro
 		return errors.InvalidArgument("received nil pointer dereference for search communication filter", errors.WithID("model.communication.validate"))
 	}
 
+	switch searchCommunicationsFilter.Consent {
+	case ConsentFilterAny, ConsentFilterGranted, ConsentFilterRevoked, ConsentFilterUnknown:
+	default:
+		return errors.InvalidArgument("unknown consent filter: "+string(searchCommunicationsFilter.Consent), errors.WithID("model.communication.validate"))
+	}
+
+	for _, kind := range searchCommunicationsFilter.Kinds {
+		if !kind.Valid() {
+			return errors.InvalidArgument("unknown via kind: "+string(kind), errors.WithID("model.communication.validate"))
+		}
+	}
+
 	return nil
 }
 
+// Normalize expands Vias into the stored forms they may have.
+func (searchCommunicationsFilter *SearchViaCommunicationsFilter) Normalize() {
+	if len(searchCommunicationsFilter.Vias) == 0 {
+		return
+	}
+
+	searchCommunicationsFilter.Vias = ViaLookupValues(searchCommunicationsFilter.Kinds, searchCommunicationsFilter.Vias)
+}
+
 type CommunicationViaPartialUpdateCmd struct {
 	ViaCommunication
 
@@ -112,6 +194,14 @@ func (communicationPartialUpdateCmd *CommunicationViaPartialUpdateCmd) Validate(
 		return errors.InvalidArgument("fields to be updated is required", errors.WithID("model.communication.validate"))
 	}
 
+	// the stored disable flag is checked by the database when disable itself isn't updated
+	if slices.Contains(communicationPartialUpdateCmd.Fields, "disabled_until") {
+		disable := communicationPartialUpdateCmd.Disable || !slices.Contains(communicationPartialUpdateCmd.Fields, "disable")
+		if err := validateDisabledUntil(disable, communicationPartialUpdateCmd.DisabledUntil); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -123,6 +213,13 @@ type CreateViaCommunicationCommand struct {
 	DisableReason *string
 	Metadata      map[string]any
 	Via           string
+	Kind          ViaKind
+	Priority      int
+	DisabledUntil *time.Time
+	// DomainID limits the iss + sub lookup of the contact to a domain, 0 looks it up in every domain.
+	DomainID int
+	// CreatedBy is the user creating the via, set from the caller identity.
+	CreatedBy int
 }
 
 func (c *CreateViaCommunicationCommand) Validate() error {
@@ -138,6 +235,10 @@ func (c *CreateViaCommunicationCommand) Validate() error {
 		return errors.InvalidArgument("via is required", errors.WithID("model.via.validate"))
 	}
 
+	if err := validateDisabledUntil(c.Disable, c.DisabledUntil); err != nil {
+		return err
+	}
+
 	if c.ContactID != uuid.Nil {
 		return nil
 	}
@@ -149,6 +250,18 @@ func (c *CreateViaCommunicationCommand) Validate() error {
 	return nil
 }
 
+// Normalize resolves the kind, guessing it when empty, and brings Via to its stored form.
+func (c *CreateViaCommunicationCommand) Normalize() error {
+	kind, normalized, err := NormalizeVia(c.Kind, c.Via)
+	if err != nil {
+		return err
+	}
+
+	c.Kind, c.Via = kind, normalized
+
+	return nil
+}
+
 func (c *CreateViaCommunicationCommand) GetContactIDPtr() *uuid.UUID {
 	if c.ContactID == uuid.Nil {
 		return nil
@@ -172,3 +285,62 @@ func (c *CreateViaCommunicationCommand) GetSubPtr() *string {
 
 	return &c.Sub
 }
+
+type DeleteViaCommand struct {
+	ContactID uuid.UUID
+	Via       string
+	// UpdatedBy is the user making the change, set from the caller identity.
+	UpdatedBy int
+}
+
+func (c *DeleteViaCommand) Validate() error {
+	if c == nil {
+		return errors.InvalidArgument("received nil pointer call for delete via command", errors.WithID("model.via.validate"))
+	}
+
+	if c.ContactID == uuid.Nil {
+		return errors.InvalidArgument("contact id is required", errors.WithID("model.via.validate"))
+	}
+
+	if strings.Trim(c.Via, " ") == "" {
+		return errors.InvalidArgument("via is required", errors.WithID("model.via.validate"))
+	}
+
+	return nil
+}
+
+type SetPrimaryViaCommand struct {
+	ContactID uuid.UUID
+	Via       string
+	// UpdatedBy is the user making the change, set from the caller identity.
+	UpdatedBy int
+}
+
+func (c *SetPrimaryViaCommand) Validate() error {
+	if c == nil {
+		return errors.InvalidArgument("received nil pointer call for set primary via command", errors.WithID("model.via.validate"))
+	}
+
+	if c.ContactID == uuid.Nil {
+		return errors.InvalidArgument("contact id is required", errors.WithID("model.via.validate"))
+	}
+
+	if strings.Trim(c.Via, " ") == "" {
+		return errors.InvalidArgument("via is required", errors.WithID("model.via.validate"))
+	}
+
+	return nil
+}
+
+// ViaChangeResult holds a changed or deleted via and, when the change left its kind without
+// a primary via, the via promoted to primary in its place.
+type ViaChangeResult struct {
+	Via      *ViaCommunication
+	Promoted *ViaCommunication
+}
+
+// SetPrimaryViaResult holds the new primary via and every via whose flag actually changed.
+type SetPrimaryViaResult struct {
+	Primary *ViaCommunication
+	Changed []*ViaCommunication
+}
@@ -0,0 +1,229 @@
+package model
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/idna"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+// ViaKind is the channel a via belongs to. It decides how the via value is normalized.
+type ViaKind string
+
+const (
+	ViaKindPhone    ViaKind = "phone"
+	ViaKindEmail    ViaKind = "email"
+	ViaKindTelegram ViaKind = "telegram"
+	ViaKindWhatsApp ViaKind = "whatsapp"
+	ViaKindCustom   ViaKind = "custom"
+)
+
+const (
+	minPhoneDigits = 8
+	maxPhoneDigits = 15
+	// minBarePhoneDigits is the length a number without "+" or "00" needs to be taken for a phone.
+	minBarePhoneDigits = 11
+)
+
+func (k ViaKind) Valid() bool {
+	switch k {
+	case ViaKindPhone, ViaKindEmail, ViaKindTelegram, ViaKindWhatsApp, ViaKindCustom:
+		return true
+	default:
+		return false
+	}
+}
+
+// NormalizeVia returns the canonical form of value for the kind.
+// An empty kind is guessed from the value, so callers that don't know the channel still hit the stored form.
+func NormalizeVia(kind ViaKind, value string) (ViaKind, string, error) {
+	if kind == "" {
+		kind = GuessViaKind(value)
+	}
+
+	var (
+		normalized string
+		err        error
+	)
+
+	switch kind {
+	case ViaKindPhone, ViaKindWhatsApp:
+		normalized, err = normalizePhone(value)
+	case ViaKindEmail:
+		normalized, err = normalizeEmail(value)
+	case ViaKindTelegram:
+		normalized, err = normalizeHandle(value)
+	case ViaKindCustom:
+		normalized = strings.TrimSpace(value)
+	default:
+		return "", "", errors.InvalidArgument("unknown via kind: "+string(kind), errors.WithID("model.via_kind.normalize"))
+	}
+
+	if err != nil {
+		return "", "", err
+	}
+
+	if normalized == "" {
+		return "", "", errors.InvalidArgument("via is required", errors.WithID("model.via_kind.normalize"))
+	}
+
+	return kind, normalized, nil
+}
+
+// GuessViaKind picks the kind for a value that came without one. Anything ambiguous is custom.
+func GuessViaKind(value string) ViaKind {
+	value = strings.TrimSpace(value)
+
+	switch {
+	case strings.HasPrefix(value, "@"):
+		if _, err := normalizeHandle(value); err == nil {
+			return ViaKindTelegram
+		}
+	case strings.Contains(value, "@"):
+		if _, err := normalizeEmail(value); err == nil {
+			return ViaKindEmail
+		}
+	default:
+		if looksLikePhone(value) {
+			return ViaKindPhone
+		}
+	}
+
+	return ViaKindCustom
+}
+
+// ViaLookupValues expands search values into every stored form they may have.
+// With kinds given each value is normalized per kind; without them the guessed form and the trimmed value are both kept.
+func ViaLookupValues(kinds []ViaKind, values []string) []string {
+	lookup := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+
+	add := func(v string) {
+		if _, ok := seen[v]; ok || v == "" {
+			return
+		}
+
+		seen[v] = struct{}{}
+		lookup = append(lookup, v)
+	}
+
+	for _, value := range values {
+		if len(kinds) == 0 {
+			add(strings.TrimSpace(value))
+
+			if _, normalized, err := NormalizeVia("", value); err == nil {
+				add(normalized)
+			}
+
+			continue
+		}
+
+		for _, kind := range kinds {
+			if _, normalized, err := NormalizeVia(kind, value); err == nil {
+				add(normalized)
+			}
+		}
+	}
+
+	return lookup
+}
+
+// ViaKeys returns the stored forms an existing via given as value may have, the most specific first:
+// the value normalized for kind when it is known, then the value as given and its form for the guessed kind.
+// Vias stored under a kind other than the guessed one, e.g. a custom "12345678901", are found by the raw value.
+func ViaKeys(kind ViaKind, value string) []string {
+	if kind == "" {
+		return ViaLookupValues(nil, []string{value})
+	}
+
+	keys := ViaLookupValues([]ViaKind{kind}, []string{value})
+	for _, key := range ViaLookupValues(nil, []string{value}) {
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// normalizePhone converts a number written with a country code into E.164.
+func normalizePhone(value string) (string, error) {
+	v := strings.TrimSpace(value)
+	switch {
+	case strings.HasPrefix(v, "+"):
+		v = v[1:]
+	case strings.HasPrefix(v, "00"):
+		v = v[2:]
+	}
+
+	var digits strings.Builder
+
+	for _, r := range v {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.':
+		default:
+			return "", errors.InvalidArgument("phone number contains invalid characters", errors.WithID("model.via_kind.normalize_phone"))
+		}
+	}
+
+	d := digits.String()
+	if len(d) < minPhoneDigits || len(d) > maxPhoneDigits {
+		return "", errors.InvalidArgument("phone number must have 8 to 15 digits including the country code", errors.WithID("model.via_kind.normalize_phone"))
+	}
+
+	if d[0] == '0' {
+		return "", errors.InvalidArgument("phone number must start with a country code", errors.WithID("model.via_kind.normalize_phone"))
+	}
+
+	return "+" + d, nil
+}
+
+func looksLikePhone(value string) bool {
+	normalized, err := normalizePhone(value)
+	if err != nil {
+		return false
+	}
+
+	if strings.HasPrefix(value, "+") || strings.HasPrefix(value, "00") {
+		return true
+	}
+
+	return len(normalized)-1 >= minBarePhoneDigits
+}
+
+// normalizeEmail lowercases the address and converts the domain to its ASCII (IDNA) form.
+func normalizeEmail(value string) (string, error) {
+	v := strings.ToLower(strings.TrimSpace(value))
+
+	at := strings.LastIndexByte(v, '@')
+	if at <= 0 || at == len(v)-1 {
+		return "", errors.InvalidArgument("email must have a local part and a domain", errors.WithID("model.via_kind.normalize_email"))
+	}
+
+	local, domain := v[:at], strings.TrimSuffix(v[at+1:], ".")
+	if strings.ContainsFunc(local, unicode.IsSpace) {
+		return "", errors.InvalidArgument("email must not contain spaces", errors.WithID("model.via_kind.normalize_email"))
+	}
+
+	asciiDomain, err := idna.Lookup.ToASCII(domain)
+	if err != nil {
+		return "", errors.InvalidArgument("email domain is invalid", errors.WithCause(err), errors.WithID("model.via_kind.normalize_email"))
+	}
+
+	return local + "@" + asciiDomain, nil
+}
+
+// normalizeHandle trims a messenger handle and drops the leading "@"; handles are case-insensitive.
+func normalizeHandle(value string) (string, error) {
+	v := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(value), "@"))
+	if v == "" || strings.ContainsFunc(v, unicode.IsSpace) {
+		return "", errors.InvalidArgument("handle must be a single word", errors.WithID("model.via_kind.normalize_handle"))
+	}
+
+	return v, nil
+}
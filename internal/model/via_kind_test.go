@@ -0,0 +1,96 @@
+package model
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestNormalizeVia(t *testing.T) {
+	tests := []struct {
+		kind      ViaKind
+		value     string
+		wantKind  ViaKind
+		wantValue string
+		wantErr   bool
+	}{
+		{kind: ViaKindPhone, value: "+380 67 123 45 67", wantKind: ViaKindPhone, wantValue: "+380671234567"},
+		{kind: ViaKindPhone, value: "00380 (67) 123-45-67", wantKind: ViaKindPhone, wantValue: "+380671234567"},
+		{kind: ViaKindPhone, value: "067 123 45 67", wantErr: true},
+		{kind: ViaKindPhone, value: "+380 67 abc", wantErr: true},
+		{kind: ViaKindWhatsApp, value: "380671234567", wantKind: ViaKindWhatsApp, wantValue: "+380671234567"},
+		{kind: ViaKindEmail, value: " Foo@Example.com ", wantKind: ViaKindEmail, wantValue: "foo@example.com"},
+		{kind: ViaKindEmail, value: "user@Пример.рф", wantKind: ViaKindEmail, wantValue: "user@xn--e1afmkfd.xn--p1ai"},
+		{kind: ViaKindEmail, value: "@example.com", wantErr: true},
+		{kind: ViaKindTelegram, value: " @Some_User ", wantKind: ViaKindTelegram, wantValue: "some_user"},
+		{kind: ViaKindCustom, value: "  Room 42  ", wantKind: ViaKindCustom, wantValue: "Room 42"},
+		{kind: "fax", value: "123", wantErr: true},
+		{value: "380671234567", wantKind: ViaKindPhone, wantValue: "+380671234567"},
+		{value: "Foo@Example.com ", wantKind: ViaKindEmail, wantValue: "foo@example.com"},
+		{value: "@handle", wantKind: ViaKindTelegram, wantValue: "handle"},
+		{value: "123456", wantKind: ViaKindCustom, wantValue: "123456"},
+	}
+
+	for _, tt := range tests {
+		kind, value, err := NormalizeVia(tt.kind, tt.value)
+		if tt.wantErr {
+			if err == nil {
+				t.Errorf("NormalizeVia(%q, %q) = %q, want error", tt.kind, tt.value, value)
+			}
+
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("NormalizeVia(%q, %q): unexpected error: %v", tt.kind, tt.value, err)
+
+			continue
+		}
+
+		if kind != tt.wantKind || value != tt.wantValue {
+			t.Errorf("NormalizeVia(%q, %q) = (%q, %q), want (%q, %q)", tt.kind, tt.value, kind, value, tt.wantKind, tt.wantValue)
+		}
+	}
+}
+
+func TestViaLookupValues(t *testing.T) {
+	got := ViaLookupValues(nil, []string{"+380 67 123 45 67", "380671234567"})
+	want := []string{"+380 67 123 45 67", "+380671234567", "380671234567"}
+
+	if !slices.Equal(got, want) {
+		t.Fatalf("lookup values = %v, want %v", got, want)
+	}
+
+	got = ViaLookupValues([]ViaKind{ViaKindEmail}, []string{"Foo@Example.com", "not-an-email"})
+	if !slices.Equal(got, []string{"foo@example.com"}) {
+		t.Fatalf("lookup values by kind = %v", got)
+	}
+}
+
+func TestResolveByViaNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		kind ViaKind
+		want []string
+	}{
+		{name: "any kind keeps the raw value", want: []string{"12345678901", "+12345678901"}},
+		{name: "custom", kind: ViaKindCustom, want: []string{"12345678901"}},
+		{name: "phone", kind: ViaKindPhone, want: []string{"+12345678901"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &ResolveByViaRequest{DomainID: 1, Kind: tt.kind, Value: " 12345678901 "}
+			if err := request.Normalize(); err != nil {
+				t.Fatalf("normalize: %v", err)
+			}
+
+			if !slices.Equal(request.Values, tt.want) {
+				t.Fatalf("values = %v, want %v", request.Values, tt.want)
+			}
+		})
+	}
+
+	if err := (&ResolveByViaRequest{DomainID: 1, Kind: ViaKindEmail, Value: "not-an-email"}).Normalize(); err == nil {
+		t.Fatal("expected a value invalid for the kind to be rejected")
+	}
+}
@@ -0,0 +1,198 @@
+package model
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+)
+
+// MaxVias bounds the set of vias a contact may be replaced with at once.
+const MaxVias = 100
+
+// ReplaceViaItem is one via of the desired set. Items are matched against the stored vias by the
+// keys the via may be stored under, the same way single vias are resolved.
+type ReplaceViaItem struct {
+	Via string
+	// Kind is the kind the via must have. Empty keeps the kind of a stored via and is guessed for a new one.
+	Kind          ViaKind
+	Disable       bool
+	DisableReason *string
+	Metadata      map[string]any
+	Priority      int
+	DisabledUntil *time.Time
+}
+
+// ReplaceViasCommand replaces every via of the contact with Items.
+type ReplaceViasCommand struct {
+	ContactID uuid.UUID
+	Items     []*ReplaceViaItem
+	// UpdatedBy is the user making the change, set from the caller identity.
+	// It becomes created_by of the vias the replace creates.
+	UpdatedBy int
+}
+
+func (c *ReplaceViasCommand) Validate() error {
+	if c == nil {
+		return errors.InvalidArgument("received nil pointer call for replace vias command", errors.WithID("model.via.validate"))
+	}
+
+	if c.ContactID == uuid.Nil {
+		return errors.InvalidArgument("contact id is required", errors.WithID("model.via.validate"))
+	}
+
+	if len(c.Items) > MaxVias {
+		return errors.InvalidArgument(fmt.Sprintf("a contact may have at most %d vias", MaxVias), errors.WithID("model.via.validate"))
+	}
+
+	for _, item := range c.Items {
+		if item == nil || strings.TrimSpace(item.Via) == "" {
+			return errors.InvalidArgument("via is required", errors.WithID("model.via.validate"))
+		}
+
+		if item.Kind != "" && !item.Kind.Valid() {
+			return errors.InvalidArgument("unknown via kind: "+string(item.Kind), errors.WithID("model.via.validate"))
+		}
+
+		if item.DisableReason != nil && utf8.RuneCountInString(*item.DisableReason) > MaxCharactersInDisableReason {
+			return errors.InvalidArgument(
+				fmt.Sprintf("disable reason has more characters than allowed number of %d", MaxCharactersInDisableReason),
+				errors.WithID("model.via.validate"),
+			)
+		}
+
+		if err := validateDisabledUntil(item.Disable, item.DisabledUntil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ViaReplacePlan lists what has to change for the contact vias to match the desired set.
+type ViaReplacePlan struct {
+	Create []*ReplaceViaItem
+	Update []*ReplaceViaItem
+	Delete []string
+}
+
+func (p *ViaReplacePlan) Empty() bool {
+	return len(p.Create) == 0 && len(p.Update) == 0 && len(p.Delete) == 0
+}
+
+// PlanViaReplace diffs the stored vias against the desired items.
+// Each item is resolved to the stored via it addresses and takes its stored key, and its kind when none is given,
+// so a via is never re-created or re-kinded only because its kind would be guessed differently today.
+// Items without a stored via are normalized for creation. Items resolving to the same via are rejected.
+// A via is updated only when one of the replaceable fields differs; primary and verification state are kept.
+func PlanViaReplace(existing []*ViaCommunication, items []*ReplaceViaItem) (*ViaReplacePlan, error) {
+	plan := new(ViaReplacePlan)
+	stored := make(map[string]*ViaCommunication, len(existing))
+	seen := make(map[string]struct{}, len(items))
+
+	for _, via := range existing {
+		stored[via.Via] = via
+	}
+
+	for _, item := range items {
+		via := resolveStoredVia(stored, item)
+		if via == nil {
+			kind, normalized, err := NormalizeVia(item.Kind, item.Via)
+			if err != nil {
+				return nil, err
+			}
+
+			item.Kind, item.Via = kind, normalized
+		} else {
+			item.Via = via.Via
+			if item.Kind == "" {
+				item.Kind = via.Kind
+			}
+		}
+
+		if _, ok := seen[item.Via]; ok {
+			return nil, errors.InvalidArgument(
+				"duplicate via "+item.Via+": a value can have only one kind per contact",
+				errors.WithID("model.via.plan_replace"),
+			)
+		}
+
+		seen[item.Via] = struct{}{}
+
+		switch {
+		case via == nil:
+			plan.Create = append(plan.Create, item)
+		case !item.matches(via):
+			plan.Update = append(plan.Update, item)
+		}
+	}
+
+	for _, via := range existing {
+		if _, ok := seen[via.Via]; !ok {
+			plan.Delete = append(plan.Delete, via.Via)
+		}
+	}
+
+	return plan, nil
+}
+
+// resolveStoredVia returns the stored via the item addresses, trying its keys in order of preference.
+// A via of another kind is taken over by an item of an explicit kind only when it is already in the form of that kind.
+func resolveStoredVia(stored map[string]*ViaCommunication, item *ReplaceViaItem) *ViaCommunication {
+	for _, key := range ViaKeys(item.Kind, item.Via) {
+		via, ok := stored[key]
+		if !ok {
+			continue
+		}
+
+		if item.Kind == "" || item.Kind == via.Kind {
+			return via
+		}
+
+		if _, normalized, err := NormalizeVia(item.Kind, via.Via); err == nil && normalized == via.Via {
+			return via
+		}
+	}
+
+	return nil
+}
+
+func (item *ReplaceViaItem) matches(via *ViaCommunication) bool {
+	return item.Kind == via.Kind &&
+		item.Disable == via.Disable &&
+		item.Priority == via.Priority &&
+		equalStringPtr(item.DisableReason, via.DisableReason) &&
+		equalTimePtr(item.DisabledUntil, via.DisabledUntil) &&
+		(len(item.Metadata) == 0 && len(via.Metadata) == 0 || reflect.DeepEqual(item.Metadata, via.Metadata))
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
+// equalTimePtr compares at millisecond precision, the one the API carries.
+func equalTimePtr(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.UnixMilli() == b.UnixMilli()
+}
+
+// ReplaceViasResult holds the contact vias after the replace and the ones that actually changed.
+// Updated includes the vias promoted to primary in place of a deleted or re-kinded primary via.
+type ReplaceViasResult struct {
+	Vias    []*ViaCommunication
+	Created []*ViaCommunication
+	Updated []*ViaCommunication
+	Deleted []*ViaCommunication
+}
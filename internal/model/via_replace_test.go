@@ -0,0 +1,124 @@
+package model
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestPlanViaReplace(t *testing.T) {
+	reason := "bounced"
+
+	existing := []*ViaCommunication{
+		{Via: "+380671234567", Kind: ViaKindPhone, Metadata: map[string]any{}},
+		{Via: "foo@example.com", Kind: ViaKindEmail, Disable: true, DisableReason: &reason},
+		{Via: "old_handle", Kind: ViaKindTelegram, Primary: true},
+	}
+
+	items := []*ReplaceViaItem{
+		{Via: "+380671234567", Kind: ViaKindPhone},
+		{Via: "foo@example.com", Kind: ViaKindEmail},
+		{Via: "new_handle", Kind: ViaKindTelegram},
+	}
+
+	plan, err := PlanViaReplace(existing, items)
+	if err != nil {
+		t.Fatalf("planning: %v", err)
+	}
+
+	if len(plan.Create) != 1 || plan.Create[0].Via != "new_handle" {
+		t.Errorf("create = %v, want new_handle", plan.Create)
+	}
+
+	if len(plan.Update) != 1 || plan.Update[0].Via != "foo@example.com" {
+		t.Errorf("update = %v, want foo@example.com", plan.Update)
+	}
+
+	if !slices.Equal(plan.Delete, []string{"old_handle"}) {
+		t.Errorf("delete = %v, want [old_handle]", plan.Delete)
+	}
+
+	if plan, err := PlanViaReplace(existing[:1], items[:1]); err != nil || !plan.Empty() {
+		t.Errorf("expected an unchanged via to need no changes, got %+v, %v", plan, err)
+	}
+}
+
+func TestPlanViaReplaceKeepsStoredKinds(t *testing.T) {
+	existing := []*ViaCommunication{
+		{Via: "+4915112345678", Kind: ViaKindWhatsApp},
+		{Via: "12345678901", Kind: ViaKindCustom},
+		{Via: "Support@Example.com", Kind: ViaKindCustom},
+	}
+
+	items := []*ReplaceViaItem{
+		{Via: "+49 151 1234 5678"},
+		{Via: " 12345678901 "},
+		{Via: "Support@Example.com"},
+	}
+
+	plan, err := PlanViaReplace(existing, items)
+	if err != nil {
+		t.Fatalf("planning: %v", err)
+	}
+
+	if !plan.Empty() {
+		t.Fatalf("expected vias without a kind to keep the stored ones, got %+v", plan)
+	}
+
+	for i, item := range items {
+		if item.Via != existing[i].Via || item.Kind != existing[i].Kind {
+			t.Errorf("item %d resolved to %s %q, want %s %q", i, item.Kind, item.Via, existing[i].Kind, existing[i].Via)
+		}
+	}
+}
+
+func TestPlanViaReplaceExplicitKind(t *testing.T) {
+	existing := []*ViaCommunication{
+		{Via: "+4915112345678", Kind: ViaKindCustom},
+		{Via: "12345678901", Kind: ViaKindCustom},
+	}
+
+	items := []*ReplaceViaItem{
+		{Via: "+4915112345678", Kind: ViaKindWhatsApp},
+		{Via: "12345678901", Kind: ViaKindPhone},
+	}
+
+	plan, err := PlanViaReplace(existing, items)
+	if err != nil {
+		t.Fatalf("planning: %v", err)
+	}
+
+	if len(plan.Update) != 1 || plan.Update[0].Via != "+4915112345678" || plan.Update[0].Kind != ViaKindWhatsApp {
+		t.Errorf("update = %+v, want the whatsapp kind on +4915112345678", plan.Update)
+	}
+
+	// the custom id isn't a phone in its stored form, the phone is a via of its own
+	if len(plan.Create) != 1 || plan.Create[0].Via != "+12345678901" {
+		t.Errorf("create = %+v, want +12345678901", plan.Create)
+	}
+
+	if !slices.Equal(plan.Delete, []string{"12345678901"}) {
+		t.Errorf("delete = %v, want [12345678901]", plan.Delete)
+	}
+}
+
+func TestPlanViaReplaceRejectsDuplicates(t *testing.T) {
+	items := []*ReplaceViaItem{
+		{Via: "+380 67 123 45 67", Kind: ViaKindPhone},
+		{Via: "380671234567", Kind: ViaKindPhone},
+	}
+
+	if _, err := PlanViaReplace(nil, items); err == nil {
+		t.Fatal("expected vias normalizing to the same value to be rejected")
+	}
+
+	existing := []*ViaCommunication{{Via: "+380671234567", Kind: ViaKindWhatsApp}}
+
+	items = []*ReplaceViaItem{
+		{Via: "+380671234567"},
+		{Via: "+380 67 123 45 67"},
+	}
+
+	if _, err := PlanViaReplace(existing, items); err == nil {
+		t.Fatal("expected items resolving to the same stored via to be rejected")
+	}
+}
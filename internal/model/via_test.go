@@ -0,0 +1,42 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPartialUpdateDisabledUntil(t *testing.T) {
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		fields        []string
+		disable       bool
+		disabledUntil *time.Time
+		wantErr       bool
+	}{
+		{name: "suspend", fields: []string{"disable", "disabled_until"}, disable: true, disabledUntil: &future},
+		{name: "suspend already disabled", fields: []string{"disabled_until"}, disabledUntil: &future},
+		{name: "clear suspension", fields: []string{"disabled_until"}},
+		{name: "suspend while enabling", fields: []string{"disable", "disabled_until"}, disabledUntil: &future, wantErr: true},
+		{name: "suspend into the past", fields: []string{"disabled_until"}, disabledUntil: &past, wantErr: true},
+		{name: "untouched", fields: []string{"metadata"}, disabledUntil: &past},
+	}
+
+	for _, tt := range tests {
+		cmd := &CommunicationViaPartialUpdateCmd{
+			ViaCommunication: ViaCommunication{
+				ContactID:     [16]byte{1},
+				Via:           "+380671234567",
+				Disable:       tt.disable,
+				DisabledUntil: tt.disabledUntil,
+			},
+			Fields: tt.fields,
+		}
+
+		if err := cmd.Validate(); (err != nil) != tt.wantErr {
+			t.Errorf("%s: Validate() error = %v, want error %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
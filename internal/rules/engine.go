@@ -0,0 +1,212 @@
+// Package rules compiles and evaluates per-domain CEL privacy rules.
+package rules
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/uuid"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/infra/db/pg"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+const (
+	// domainRulesTTL bounds how long other instances keep evaluating rules
+	// that were changed through a different instance.
+	domainRulesTTL = 30 * time.Second
+	// costLimit stops runaway expressions, e.g. comprehensions over large lists.
+	costLimit = 10_000
+)
+
+// Compiled is a privacy rule ready for evaluation.
+type Compiled struct {
+	Rule *model.PrivacyRule
+
+	program cel.Program
+	err     error
+}
+
+// Eval reports whether the rule allows from to reach to.
+// A rule that failed to compile never allows anything.
+func (c *Compiled) Eval(from, to *model.Contact, settings *model.ContactSettings) (bool, error) {
+	if c.err != nil {
+		return false, c.err
+	}
+
+	out, _, err := c.program.Eval(map[string]any{
+		"from":     contactVars(from),
+		"to":       contactVars(to),
+		"settings": settingsVars(settings),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	// dyn expressions, e.g. from.is_bot, pass compilation and are checked here
+	allowed, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.Internal("privacy rule returned "+string(out.Type().TypeName())+" instead of bool", errors.WithID("rules.engine.eval"))
+	}
+
+	return allowed, nil
+}
+
+type domainRules struct {
+	rules    []*Compiled
+	loadedAt time.Time
+}
+
+// Engine compiles rules once and caches the compiled rules of each domain.
+// Rules cannot be changed after creation, so a compiled rule is reused by its ID
+// for as long as the rule is stored.
+type Engine struct {
+	env    *cel.Env
+	store  store.PrivacyRuleStore
+	logger *slog.Logger
+
+	mu      sync.RWMutex
+	domains map[int]domainRules
+}
+
+func NewEngine(store store.PrivacyRuleStore, logger *slog.Logger) (*Engine, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("from", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("to", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("settings", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, errors.Internal("creating CEL environment", errors.WithCause(err), errors.WithID("rules.engine.new"))
+	}
+
+	return &Engine{
+		env:     env,
+		store:   store,
+		logger:  logger.With("component", "privacy_rules"),
+		domains: make(map[int]domainRules),
+	}, nil
+}
+
+// Compile checks that expression is a valid boolean CEL expression and returns its program.
+// Expressions of dyn type are accepted, their result is checked on evaluation.
+func (e *Engine) Compile(expression string) (cel.Program, error) {
+	ast, issues := e.env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, errors.InvalidArgument("compiling privacy rule: "+issues.Err().Error(), errors.WithID("rules.engine.compile"))
+	}
+
+	if out := ast.OutputType(); out != cel.BoolType && out != cel.DynType {
+		return nil, errors.InvalidArgument("privacy rule must return bool, got "+ast.OutputType().String(), errors.WithID("rules.engine.compile"))
+	}
+
+	program, err := e.env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, errors.InvalidArgument("building privacy rule program", errors.WithCause(err), errors.WithID("rules.engine.compile"))
+	}
+
+	return program, nil
+}
+
+// Rules returns the compiled rules of the domain, loading them from the store when the cache is stale.
+func (e *Engine) Rules(ctx context.Context, domainID int) ([]*Compiled, error) {
+	e.mu.RLock()
+	cached, ok := e.domains[domainID]
+	e.mu.RUnlock()
+
+	if ok && time.Since(cached.loadedAt) < domainRulesTTL {
+		return cached.rules, nil
+	}
+
+	// reloads usually follow a rule change, which a lagging replica may not have yet
+	stored, err := e.store.List(pg.WithMaster(ctx), domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the rules still stored are kept, so deleted rules leave the cache on reload
+	previous := make(map[uuid.UUID]*Compiled, len(cached.rules))
+	for _, rule := range cached.rules {
+		previous[rule.Rule.ID] = rule
+	}
+
+	compiled := make([]*Compiled, 0, len(stored))
+	for _, rule := range stored {
+		if known, ok := previous[rule.ID]; ok {
+			compiled = append(compiled, known)
+
+			continue
+		}
+
+		program, err := e.Compile(rule.Expression)
+		if err != nil {
+			e.logger.Error("compiling stored privacy rule", "error", err, "rule_id", rule.ID, "domain_id", domainID)
+		}
+
+		compiled = append(compiled, &Compiled{Rule: rule, program: program, err: err})
+	}
+
+	e.mu.Lock()
+	e.domains[domainID] = domainRules{rules: compiled, loadedAt: time.Now()}
+	e.mu.Unlock()
+
+	return compiled, nil
+}
+
+// Invalidate makes the next [Engine.Rules] call reload the rules of the domain.
+// Rules that are still stored keep their compiled programs.
+func (e *Engine) Invalidate(domainID int) {
+	e.mu.Lock()
+	if cached, ok := e.domains[domainID]; ok {
+		cached.loadedAt = time.Time{}
+		e.domains[domainID] = cached
+	}
+	e.mu.Unlock()
+}
+
+func contactVars(c *model.Contact) map[string]any {
+	if c == nil {
+		return map[string]any{}
+	}
+
+	metadata := c.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+
+	return map[string]any{
+		"id":             c.ID.String(),
+		"domain_id":      int64(c.DomainID),
+		"issuer_id":      c.IssuerID,
+		"subject_id":     c.SubjectID,
+		"application_id": c.ApplicationID,
+		"type":           c.Type,
+		"name":           c.Name,
+		"username":       c.Username,
+		"metadata":       metadata,
+		"is_bot":         c.IsBot,
+	}
+}
+
+func settingsVars(s *model.ContactSettings) map[string]any {
+	if s == nil {
+		return map[string]any{}
+	}
+
+	allowed := make([]string, len(s.AllowedContacts))
+	for i, id := range s.AllowedContacts {
+		allowed[i] = id.String()
+	}
+
+	return map[string]any{
+		"allow_invites_from":  int64(s.AllowInvitesFrom),
+		"allow_messages_from": int64(s.AllowMessagesFrom),
+		"allowed_contacts":    allowed,
+		"discoverable":        s.Discoverable,
+	}
+}
@@ -0,0 +1,162 @@
+package rules
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/im-contact-service/infra/db/pg"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+type fakeRuleStore struct {
+	store.PrivacyRuleStore
+
+	rules []*model.PrivacyRule
+	reads int
+	// replicaReads counts the reads that were allowed to go to a replica.
+	replicaReads int
+}
+
+func (f *fakeRuleStore) List(ctx context.Context, _ int) ([]*model.PrivacyRule, error) {
+	f.reads++
+
+	if !pg.IsMasterForced(ctx) {
+		f.replicaReads++
+	}
+
+	return f.rules, nil
+}
+
+func newTestEngine(t *testing.T, rules ...*model.PrivacyRule) (*Engine, *fakeRuleStore) {
+	t.Helper()
+
+	fake := &fakeRuleStore{rules: rules}
+
+	engine, err := NewEngine(fake, slog.New(slog.DiscardHandler))
+	if err != nil {
+		t.Fatalf("new engine: %v", err)
+	}
+
+	return engine, fake
+}
+
+func TestCompileRejectsNonBool(t *testing.T) {
+	engine, _ := newTestEngine(t)
+
+	if _, err := engine.Compile(`size(from.name)`); err == nil {
+		t.Fatal("expected non-bool expression to be rejected")
+	}
+
+	if _, err := engine.Compile(`from.type ==`); err == nil {
+		t.Fatal("expected invalid expression to be rejected")
+	}
+
+	if _, err := engine.Compile(`from.application_id == to.application_id`); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestRulesEval(t *testing.T) {
+	engine, fake := newTestEngine(t,
+		&model.PrivacyRule{
+			ID:         uuid.New(),
+			Name:       "customers reach agents of their application",
+			Expression: `from.type != "customer" || (to.type == "agent" && from.application_id == to.application_id)`,
+		},
+		&model.PrivacyRule{ID: uuid.New(), Name: "broken", Expression: `from.`},
+	)
+
+	compiled, err := engine.Rules(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("loading rules: %v", err)
+	}
+
+	if _, err := engine.Rules(context.Background(), 1); err != nil || fake.reads != 1 {
+		t.Fatalf("expected cached rules, reads = %d, err = %v", fake.reads, err)
+	}
+
+	customer := &model.Contact{Type: "customer", ApplicationID: "app-1"}
+
+	tests := []struct {
+		name string
+		to   *model.Contact
+		want bool
+	}{
+		{name: "same application agent", to: &model.Contact{Type: "agent", ApplicationID: "app-1"}, want: true},
+		{name: "other application agent", to: &model.Contact{Type: "agent", ApplicationID: "app-2"}, want: false},
+		{name: "customer", to: &model.Contact{Type: "customer", ApplicationID: "app-1"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := compiled[0].Eval(customer, tt.to, &model.ContactSettings{})
+			if err != nil {
+				t.Fatalf("eval: %v", err)
+			}
+
+			if got != tt.want {
+				t.Fatalf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if allowed, err := compiled[1].Eval(customer, customer, nil); err == nil || allowed {
+		t.Fatal("expected broken rule to deny")
+	}
+}
+
+func TestDynRulesCheckedOnEval(t *testing.T) {
+	engine, _ := newTestEngine(t,
+		&model.PrivacyRule{ID: uuid.New(), Name: "bots only", Expression: `from.is_bot`},
+		&model.PrivacyRule{ID: uuid.New(), Name: "name", Expression: `from.name`},
+	)
+
+	compiled, err := engine.Rules(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("loading rules: %v", err)
+	}
+
+	if allowed, err := compiled[0].Eval(&model.Contact{IsBot: true}, &model.Contact{}, nil); err != nil || !allowed {
+		t.Fatalf("dyn bool rule: allowed = %v, err = %v", allowed, err)
+	}
+
+	if allowed, err := compiled[1].Eval(&model.Contact{Name: "bot"}, &model.Contact{}, nil); err == nil || allowed {
+		t.Fatal("expected a rule evaluating to a string to fail")
+	}
+}
+
+func TestRulesReloadKeepsOnlyStoredRules(t *testing.T) {
+	kept := &model.PrivacyRule{ID: uuid.New(), Name: "kept", Expression: `!from.is_bot`}
+	deleted := &model.PrivacyRule{ID: uuid.New(), Name: "deleted", Expression: `from.is_bot`}
+
+	engine, fake := newTestEngine(t, kept, deleted)
+
+	before, err := engine.Rules(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("loading rules: %v", err)
+	}
+
+	fake.rules = []*model.PrivacyRule{kept}
+	engine.Invalidate(1)
+
+	after, err := engine.Rules(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("reloading rules: %v", err)
+	}
+
+	if fake.reads != 2 {
+		t.Fatalf("expected invalidated rules to be reloaded, reads = %d", fake.reads)
+	}
+
+	if fake.replicaReads != 0 {
+		t.Fatalf("rules must be reloaded from the master, %d reads went to a replica", fake.replicaReads)
+	}
+
+	if len(after) != 1 || after[0] != before[0] {
+		t.Fatalf("expected only the stored rule with its compiled program, got %d rules", len(after))
+	}
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/infra/auth"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+// access enforces the caller identity put into the context by the server.
+// Domain and contact fields of a request are only trusted as far as the identity allows them.
+type access struct {
+	contacts store.ContactStore
+}
+
+func newAccess(contacts store.ContactStore) access {
+	return access{contacts: contacts}
+}
+
+func callerIdentity(ctx context.Context) (*auth.Identity, error) {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthenticated("caller identity is required", errors.WithID("service.access.identity"))
+	}
+
+	return identity, nil
+}
+
+// domain returns the domain the caller may act in. A requested domain of 0 means the caller's one;
+// a cross-domain service gets the requested domain as is.
+func (a access) domain(ctx context.Context, requested int) (int, error) {
+	identity, err := callerIdentity(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if identity.CrossDomain() {
+		return requested, nil
+	}
+
+	if requested != 0 && requested != identity.DomainID {
+		return 0, errors.Forbidden("caller can't access another domain", errors.WithID("service.access.domain"))
+	}
+
+	return identity.DomainID, nil
+}
+
+// manageDomain is [access.domain] for operations that aren't available to contacts, e.g. domain policies.
+func (a access) manageDomain(ctx context.Context, requested int) (int, error) {
+	identity, err := callerIdentity(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if identity.IsContact() {
+		return 0, errors.Forbidden("contacts can't manage the domain", errors.WithID("service.access.manage_domain"))
+	}
+
+	return a.domain(ctx, requested)
+}
+
+// contact checks that the caller may change the contact: a contact only itself, anyone else only contacts of its domain.
+// A contact of another domain is reported as missing so that its existence doesn't leak.
+func (a access) contact(ctx context.Context, contactID uuid.UUID) error {
+	identity, err := callerIdentity(ctx)
+	if err != nil {
+		return err
+	}
+
+	if identity.IsContact() {
+		if contactID != identity.ContactID {
+			return errors.Forbidden("contact can access only own data", errors.WithID("service.access.contact"))
+		}
+
+		return nil
+	}
+
+	if identity.CrossDomain() || contactID == uuid.Nil {
+		return nil
+	}
+
+	contacts, err := a.contacts.FindByIDs(ctx, []uuid.UUID{contactID})
+	if err != nil {
+		return err
+	}
+
+	if len(contacts) == 0 || contacts[0].DomainID != identity.DomainID {
+		return errors.NotFound("contact doesn`t exist", errors.WithID("service.access.contact"))
+	}
+
+	return nil
+}
+
+// initiator returns the contact the caller acts as, uuid.Nil for non-contact callers.
+func (a access) initiator(ctx context.Context) (uuid.UUID, error) {
+	identity, err := callerIdentity(ctx)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return identity.ContactID, nil
+}
+
+// actor returns the user the caller acts as, recorded in created_by and updated_by; 0 for services.
+func (a access) actor(ctx context.Context) int {
+	if identity, ok := auth.FromContext(ctx); ok {
+		return identity.UserID
+	}
+
+	return 0
+}
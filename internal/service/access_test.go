@@ -0,0 +1,94 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/infra/auth"
+	"github.com/webitel/im-contact-service/internal/model"
+)
+
+func TestAccessContact(t *testing.T) {
+	var (
+		own   = uuid.New()
+		other = uuid.New()
+	)
+
+	a := newAccess(&fakeContactStore{contacts: map[uuid.UUID]*model.Contact{
+		own:   {BaseModel: model.BaseModel{ID: own, DomainID: 1}},
+		other: {BaseModel: model.BaseModel{ID: other, DomainID: 2}},
+	}})
+
+	tests := []struct {
+		name      string
+		identity  *auth.Identity
+		contactID uuid.UUID
+		want      codes.Code
+	}{
+		{name: "bearer user in the contact domain", identity: &auth.Identity{DomainID: 1, UserID: 10}, contactID: own, want: codes.OK},
+		{name: "bearer user in another domain", identity: &auth.Identity{DomainID: 1, UserID: 10}, contactID: other, want: codes.NotFound},
+		{name: "domain service in another domain", identity: &auth.Identity{DomainID: 1, Service: true}, contactID: other, want: codes.NotFound},
+		{name: "cross-domain service", identity: &auth.Identity{Service: true}, contactID: other, want: codes.OK},
+		{name: "contact itself", identity: &auth.Identity{DomainID: 1, ContactID: own}, contactID: own, want: codes.OK},
+		{name: "contact touching another contact", identity: &auth.Identity{DomainID: 1, ContactID: own}, contactID: other, want: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := a.contact(auth.NewContext(context.Background(), tt.identity), tt.contactID)
+			if errors.Code(err) != tt.want {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if err := a.contact(context.Background(), own); errors.Code(err) != codes.Unauthenticated {
+		t.Fatalf("without identity: err = %v, want Unauthenticated", err)
+	}
+}
+
+func TestAccessDomain(t *testing.T) {
+	var a access
+
+	tests := []struct {
+		name      string
+		identity  *auth.Identity
+		requested int
+		manage    bool
+		want      int
+		code      codes.Code
+	}{
+		{name: "own domain by default", identity: &auth.Identity{DomainID: 1, UserID: 10}, want: 1},
+		{name: "own domain requested", identity: &auth.Identity{DomainID: 1, UserID: 10}, requested: 1, want: 1},
+		{name: "another domain requested", identity: &auth.Identity{DomainID: 1, UserID: 10}, requested: 2, code: codes.PermissionDenied},
+		{name: "cross-domain service", identity: &auth.Identity{Service: true}, requested: 2, want: 2},
+		{name: "user manages own domain", identity: &auth.Identity{DomainID: 1, UserID: 10}, manage: true, want: 1},
+		{name: "user manages another domain", identity: &auth.Identity{DomainID: 1, UserID: 10}, requested: 2, manage: true, code: codes.PermissionDenied},
+		{name: "contact manages domain", identity: &auth.Identity{DomainID: 1, ContactID: uuid.New()}, manage: true, code: codes.PermissionDenied},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := auth.NewContext(context.Background(), tt.identity)
+
+			check := a.domain
+			if tt.manage {
+				check = a.manageDomain
+			}
+
+			got, err := check(ctx, tt.requested)
+			if errors.Code(err) != tt.code {
+				t.Fatalf("err = %v, want %v", err, tt.code)
+			}
+
+			if got != tt.want {
+				t.Fatalf("domain = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
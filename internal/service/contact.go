@@ -8,6 +8,7 @@ import (
 
 	"github.com/webitel/webitel-go-kit/pkg/errors"
 
+	"github.com/webitel/im-contact-service/infra/db/pg"
 	"github.com/webitel/im-contact-service/internal/domain/events"
 	"github.com/webitel/im-contact-service/internal/handler/amqp"
 	"github.com/webitel/im-contact-service/internal/model"
@@ -27,17 +28,21 @@ type EventPublisher interface {
 }
 
 type contactService struct {
-	logger    *slog.Logger
-	store     store.ContactStore
-	publisher EventPublisher
+	logger        *slog.Logger
+	store         store.ContactStore
+	settingsStore store.SettingsStore
+	publisher     EventPublisher
+	access        access
 }
 
 // NewContactService creates a new ContactService instance.
-func NewContactService(store store.ContactStore, publisher EventPublisher, logger *slog.Logger) ContactService {
+func NewContactService(store store.ContactStore, settingsStore store.SettingsStore, publisher EventPublisher, logger *slog.Logger) ContactService {
 	return &contactService{
-		store:     store,
-		publisher: publisher,
-		logger:    logger.With("component", "contact_service"),
+		store:         store,
+		settingsStore: settingsStore,
+		publisher:     publisher,
+		access:        newAccess(store),
+		logger:        logger.With("component", "contact_service"),
 	}
 }
 
@@ -47,6 +52,29 @@ func (s *contactService) Search(ctx context.Context, filter *model.ContactSearch
 		return nil, errors.InvalidArgument("filter is required", errors.WithID("service.contact.search"))
 	}
 
+	var requested int
+	if filter.DomainID != nil {
+		requested = *filter.DomainID
+	}
+
+	domainID, err := s.access.domain(ctx, requested)
+	if err != nil {
+		return nil, err
+	}
+
+	if domainID != 0 {
+		filter.DomainID = &domainID
+	}
+
+	initiator, err := s.access.initiator(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if initiator != uuid.Nil {
+		filter.InitiatorContactID = initiator
+	}
+
 	return s.store.Search(ctx, filter)
 }
 
@@ -56,6 +84,15 @@ func (s *contactService) Create(ctx context.Context, input *model.Contact) (*mod
 		return nil, err
 	}
 
+	domainID, err := s.access.manageDomain(ctx, input.DomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	input.DomainID = domainID
+	input.CreatedBy = s.access.actor(ctx)
+	input.UpdatedBy = input.CreatedBy
+
 	// TODO
 	// appClient.ValidateApplicationAccess(ctx, input.ApplicationId, input.IssuerId)
 
@@ -72,6 +109,10 @@ func (s *contactService) Create(ctx context.Context, input *model.Contact) (*mod
 		return out, err
 	}
 
+	if err := s.announceSettings(ctx, out.ID); err != nil {
+		return out, err
+	}
+
 	return out, nil
 }
 
@@ -84,6 +125,15 @@ func (s *contactService) Upsert(ctx context.Context, contact *model.Contact) (*m
 		return nil, err
 	}
 
+	domainID, err := s.access.manageDomain(ctx, contact.DomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	contact.DomainID = domainID
+	contact.CreatedBy = s.access.actor(ctx)
+	contact.UpdatedBy = contact.CreatedBy
+
 	contact, isInsert, err := s.store.Upsert(ctx, contact)
 	if err != nil {
 		log.Error("performing upsert query for contact", "error", err)
@@ -96,6 +146,10 @@ func (s *contactService) Upsert(ctx context.Context, contact *model.Contact) (*m
 		if err := s.publisher.Publish(ctx, event); err != nil {
 			return contact, err
 		}
+
+		if err := s.announceSettings(ctx, contact.ID); err != nil {
+			return contact, err
+		}
 	} else {
 		event := events.NewContactUpdated(contact)
 		if err := s.publisher.Publish(ctx, event); err != nil {
@@ -106,12 +160,37 @@ func (s *contactService) Upsert(ctx context.Context, contact *model.Contact) (*m
 	return contact, nil
 }
 
+// announceSettings publishes a SettingsCreatedEvent for the settings row
+// created by the create_setting_on_insert trigger.
+func (s *contactService) announceSettings(ctx context.Context, contactID uuid.UUID) error {
+	settings, err := s.settingsStore.Get(pg.WithMaster(ctx), contactID)
+	if err != nil {
+		s.logger.Error("reading settings of created contact", "error", err, "contact_id", contactID)
+
+		return err
+	}
+
+	return s.publisher.Publish(ctx, events.NewSettingsCreated(settings))
+}
+
 // Update modifies an existing contact and publishes a ContactUpdatedEvent.
 func (s *contactService) Update(ctx context.Context, input *model.UpdateContactRequest) (*model.Contact, error) {
 	if input == nil || input.ID == uuid.Nil {
 		return nil, errors.InvalidArgument("input with a valid ID is required")
 	}
 
+	domainID, err := s.access.domain(ctx, input.DomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.access.contact(ctx, input.ID); err != nil {
+		return nil, err
+	}
+
+	input.DomainID = domainID
+	input.UpdatedBy = s.access.actor(ctx)
+
 	out, err := s.store.Update(ctx, input)
 	if err != nil {
 		return nil, err
@@ -131,6 +210,13 @@ func (s *contactService) Delete(ctx context.Context, input *model.DeleteContactR
 		return errors.InvalidArgument("id is required")
 	}
 
+	domainID, err := s.access.manageDomain(ctx, input.DomainID)
+	if err != nil {
+		return err
+	}
+
+	input.DomainID = domainID
+
 	if input.DomainID == 0 {
 		return errors.InvalidArgument("domainId is required")
 	}
@@ -144,7 +230,7 @@ func (s *contactService) Delete(ctx context.Context, input *model.DeleteContactR
 }
 
 func (s *contactService) DeleteByDomain(ctx context.Context, domainID int) error {
-	err := s.store.ClearByDomain(ctx, domainID)
+	_, err := s.store.ClearByDomain(ctx, domainID)
 	if err != nil {
 		return err
 	}
@@ -157,7 +243,7 @@ func (s *contactService) DeleteBotByFlowID(ctx context.Context, flowID string) e
 		return errors.InvalidArgument("flow id required to delete bot by flow id")
 	}
 
-	err := s.store.DeleteBotByFlowID(ctx, flowID)
+	_, err := s.store.DeleteBotByFlowID(ctx, flowID)
 	if err != nil {
 		return err
 	}
@@ -166,11 +252,27 @@ func (s *contactService) DeleteBotByFlowID(ctx context.Context, flowID string) e
 }
 
 func (s *contactService) PartialUpdate(ctx context.Context, cmd *model.PartialUpdateContactRequest) (*model.Contact, error) {
+	domainID, err := s.access.domain(ctx, cmd.DomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.access.contact(ctx, cmd.ID); err != nil {
+		return nil, err
+	}
+
+	cmd.DomainID = domainID
+
 	if cmd.ID == uuid.Nil || cmd.DomainID <= 0 {
 		return nil, errors.InvalidArgument("ID and DomainID are required fields!")
 	}
 
-	query := queries.NewContactUpdateQuery().WithDomainIDFilter(cmd.DomainID).WithIDFilter(cmd.ID)
+	cmd.UpdatedBy = s.access.actor(ctx)
+
+	query := queries.NewContactUpdateQuery().
+		WithDomainIDFilter(cmd.DomainID).
+		WithIDFilter(cmd.ID).
+		WithUpdatedBy(cmd.UpdatedBy)
 
 	for _, field := range cmd.Fields {
 		switch field {
@@ -215,6 +317,17 @@ func (s *contactService) validateCreate(input *model.Contact) error {
 }
 
 func (s *contactService) Locate(ctx context.Context, locate *model.LocateContactRequest) (*model.Contact, error) {
+	if locate == nil {
+		return nil, errors.InvalidArgument("locate request is required", errors.WithID("service.contact.locate"))
+	}
+
+	domainID, err := s.access.domain(ctx, locate.DC)
+	if err != nil {
+		return nil, err
+	}
+
+	locate.DC = domainID
+
 	if err := locate.Validate(); err != nil {
 		return nil, err
 	}
@@ -226,3 +339,27 @@ func (s *contactService) Locate(ctx context.Context, locate *model.LocateContact
 
 	return contact, nil
 }
+
+// ResolveByVia finds the contacts owning the via, the primary via going first.
+func (s *contactService) ResolveByVia(ctx context.Context, request *model.ResolveByViaRequest) ([]*model.ResolvedContact, error) {
+	if request == nil {
+		return nil, errors.InvalidArgument("resolve request is required", errors.WithID("service.contact.resolve_by_via"))
+	}
+
+	domainID, err := s.access.manageDomain(ctx, request.DomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	request.DomainID = domainID
+
+	if err := request.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := request.Normalize(); err != nil {
+		return nil, err
+	}
+
+	return s.store.ResolveByVia(ctx, request)
+}
@@ -2,13 +2,18 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"slices"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
 
 	"github.com/webitel/webitel-go-kit/pkg/errors"
 
+	"github.com/webitel/im-contact-service/infra/ratelimit"
 	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/rules"
 	"github.com/webitel/im-contact-service/internal/store"
 )
 
@@ -16,10 +21,26 @@ type contactPrivacyService struct {
 	logger        *slog.Logger
 	settingsStore store.SettingsStore
 	contactStore  store.ContactStore
+	rules         *rules.Engine
+	inviteLimiter ratelimit.InviteLimiter
+	access        access
 }
 
-func NewContactPrivacyService(log *slog.Logger, settingsStore store.SettingsStore, contactStore store.ContactStore) (ContactPrivacyService, error) {
-	return &contactPrivacyService{logger: log, settingsStore: settingsStore, contactStore: contactStore}, nil
+func NewContactPrivacyService(
+	log *slog.Logger,
+	settingsStore store.SettingsStore,
+	contactStore store.ContactStore,
+	rules *rules.Engine,
+	inviteLimiter ratelimit.InviteLimiter,
+) (ContactPrivacyService, error) {
+	return &contactPrivacyService{
+		logger:        log,
+		settingsStore: settingsStore,
+		contactStore:  contactStore,
+		rules:         rules,
+		inviteLimiter: inviteLimiter,
+		access:        newAccess(contactStore),
+	}, nil
 }
 
 type ValidationFunc func(from, to *model.Contact, toSettings *model.ContactSettings) error
@@ -33,107 +54,256 @@ var (
 	sendValidators = []ValidationFunc{
 		ensureSharedDomain,
 		denyBotToBotCommunication,
+		validateAllowMessagesFrom,
 	}
 )
 
-func (s *contactPrivacyService) CanSend(ctx context.Context, request *model.CanSendRequest) error {
+func (s *contactPrivacyService) CanSend(ctx context.Context, request *model.CanSendRequest) (*model.PrivacyDecision, error) {
 	if request == nil {
-		return errors.InvalidArgument("request required")
+		return nil, errors.InvalidArgument("request required")
 	}
 
-	from, to := request.From, request.To
-	if from == uuid.Nil {
-		return errors.InvalidArgument("from required")
+	if err := s.access.contact(ctx, request.From); err != nil {
+		return nil, err
 	}
 
-	if to == uuid.Nil {
-		return errors.InvalidArgument("to required")
+	if request.To == uuid.Nil {
+		return nil, errors.InvalidArgument("to required")
 	}
 
-	fromContact, toContact, err := s.findContactPair(ctx, from, to)
+	decisions, err := s.batchCheck(ctx, request.From, []uuid.UUID{request.To}, model.PrivacyRuleScopeSend)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	settingsTo, err := s.settingsStore.Get(ctx, to)
-	if err != nil {
-		return err
+	return decisions[0], nil
+}
+
+func (s *contactPrivacyService) CanInvite(ctx context.Context, request *model.CanInviteRequest) (*model.PrivacyDecision, error) {
+	if request == nil {
+		return nil, errors.InvalidArgument("request required")
+	}
+
+	if err := s.access.contact(ctx, request.From); err != nil {
+		return nil, err
+	}
+
+	if request.To == uuid.Nil {
+		return nil, errors.InvalidArgument("to required")
 	}
 
-	err = s.validateCanSend(fromContact, toContact, settingsTo)
+	decisions, err := s.checkInvites(ctx, request.From, []uuid.UUID{request.To})
 	if err != nil {
-		return errors.Forbidden("sending forbidden", errors.WithCause(err))
+		return nil, err
 	}
 
-	return nil
+	return decisions[0], nil
 }
 
-func (s *contactPrivacyService) CanInvite(ctx context.Context, request *model.CanInviteRequest) error {
+func (s *contactPrivacyService) BatchCanSend(ctx context.Context, request *model.BatchCanSendRequest) ([]*model.PrivacyDecision, error) {
 	if request == nil {
-		return errors.InvalidArgument("request required")
+		return nil, errors.InvalidArgument("request required")
 	}
 
-	from, to := request.From, request.To
-	if from == uuid.Nil {
-		return errors.InvalidArgument("from required")
+	if len(request.To) > model.MaxPrivacyBatchSize {
+		return nil, errors.InvalidArgument(fmt.Sprintf("at most %d recipients can be checked at once", model.MaxPrivacyBatchSize), errors.WithID("service.privacy.batch_can_send"))
+	}
+
+	if err := s.access.contact(ctx, request.From); err != nil {
+		return nil, err
+	}
+
+	return s.batchCheck(ctx, request.From, request.To, model.PrivacyRuleScopeSend)
+}
+
+func (s *contactPrivacyService) BatchCanInvite(ctx context.Context, request *model.BatchCanInviteRequest) ([]*model.PrivacyDecision, error) {
+	if request == nil {
+		return nil, errors.InvalidArgument("request required")
+	}
+
+	if len(request.To) > model.MaxPrivacyBatchSize {
+		return nil, errors.InvalidArgument(fmt.Sprintf("at most %d recipients can be checked at once", model.MaxPrivacyBatchSize), errors.WithID("service.privacy.batch_can_invite"))
+	}
+
+	if err := s.access.contact(ctx, request.From); err != nil {
+		return nil, err
+	}
+
+	return s.checkInvites(ctx, request.From, request.To)
+}
+
+// checkInvites takes one token per distinct recipient from the sender's invite bucket before
+// checking privacy, so that denied probes count against the limit too.
+// A batch larger than the bucket capacity is rejected with InvalidArgument.
+func (s *contactPrivacyService) checkInvites(ctx context.Context, from uuid.UUID, to []uuid.UUID) ([]*model.PrivacyDecision, error) {
+	if from == uuid.Nil || len(to) == 0 {
+		return s.batchCheck(ctx, from, to, model.PrivacyRuleScopeInvite)
 	}
 
-	if to == uuid.Nil {
-		return errors.InvalidArgument("to required")
+	distinct := make(map[uuid.UUID]struct{}, len(to))
+	for _, id := range to {
+		distinct[id] = struct{}{}
 	}
 
-	fromContact, toContact, err := s.findContactPair(ctx, from, to)
+	limit, err := s.inviteLimiter.Take(ctx, from.String(), len(distinct))
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if limit.Allowed {
+		return s.batchCheck(ctx, from, to, model.PrivacyRuleScopeInvite)
+	}
+
+	cause := model.NewDenialError(model.DenialReasonRateLimited, errors.New("invite rate limit exceeded", errors.WithCode(codes.ResourceExhausted)))
+
+	decisions := make([]*model.PrivacyDecision, len(to))
+	for i, id := range to {
+		decisions[i] = newPrivacyDecision(id, cause)
+		decisions[i].RetryAfter = limit.RetryAfter
+	}
+
+	return decisions, nil
+}
+
+// batchCheck validates one sender against every recipient, loading contacts and
+// recipient settings with a single query each. Decisions keep the order of to.
+func (s *contactPrivacyService) batchCheck(ctx context.Context, from uuid.UUID, to []uuid.UUID, scope model.PrivacyRuleScope) ([]*model.PrivacyDecision, error) {
+	if from == uuid.Nil {
+		return nil, errors.InvalidArgument("from required")
+	}
+
+	if len(to) == 0 {
+		return nil, errors.InvalidArgument("to required")
+	}
+
+	var (
+		seen       = make(map[uuid.UUID]struct{}, len(to))
+		recipients = make([]uuid.UUID, 0, len(to))
+	)
+
+	// the sender may be among the recipients, its settings are needed then too
+	for _, id := range to {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		recipients = append(recipients, id)
+	}
+
+	ids := recipients
+	if _, ok := seen[from]; !ok {
+		ids = append(slices.Clip(recipients), from)
 	}
 
-	settingsTo, err := s.settingsStore.Get(ctx, to)
+	contacts, err := s.contactStore.FindByIDs(ctx, ids)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	err = s.validateCanInvite(fromContact, toContact, settingsTo)
+	contactsByID := make(map[uuid.UUID]*model.Contact, len(contacts))
+	for _, contact := range contacts {
+		if contact != nil {
+			contactsByID[contact.ID] = contact
+		}
+	}
+
+	settings, err := s.settingsStore.GetMany(ctx, recipients)
 	if err != nil {
-		return errors.Forbidden("inviting forbidden", errors.WithCause(err))
+		return nil, err
 	}
 
-	return nil
+	settingsByContact := make(map[uuid.UUID]*model.ContactSettings, len(settings))
+	for _, setting := range settings {
+		if setting != nil {
+			settingsByContact[setting.ContactID] = setting
+		}
+	}
+
+	fromContact := contactsByID[from]
+
+	validators := sendValidators
+	if scope == model.PrivacyRuleScopeInvite {
+		validators = inviteValidators
+	}
+
+	if fromContact != nil {
+		validators, err = s.withDomainRules(ctx, fromContact.DomainID, scope, validators)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	decisions := make([]*model.PrivacyDecision, len(to))
+	for i, id := range to {
+		toContact := contactsByID[id]
+
+		var err error
+		if fromContact == nil || toContact == nil {
+			err = model.NewDenialError(model.DenialReasonContactNotFound, errors.NotFound("can't find contacts to validate"))
+		} else {
+			err = s.checkValidationRules(fromContact, toContact, settingsByContact[id], validators)
+		}
+
+		// only denials are decisions; anything else, e.g. missing recipient settings, fails the whole check
+		var denial *model.DenialError
+		if err != nil && !errors.As(err, &denial) {
+			return nil, err
+		}
+
+		decisions[i] = newPrivacyDecision(id, err)
+	}
+
+	return decisions, nil
 }
 
-func (s *contactPrivacyService) findContactPair(ctx context.Context, from, to uuid.UUID) (fromContact, toContact *model.Contact, err error) {
-	contacts, err := s.contactStore.Search(ctx, &model.ContactSearchRequest{IDs: []uuid.UUID{from, to}})
+// withDomainRules appends the custom rules of the domain that cover scope to validators.
+func (s *contactPrivacyService) withDomainRules(ctx context.Context, domainID int, scope model.PrivacyRuleScope, validators []ValidationFunc) ([]ValidationFunc, error) {
+	domainRules, err := s.rules.Rules(ctx, domainID)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	foundLen := len(contacts)
-	if foundLen <= 0 || foundLen > 2 {
-		return nil, nil, errors.InvalidArgument("invalid contacts found")
+	if len(domainRules) == 0 {
+		return validators, nil
 	}
 
-	for _, contact := range contacts {
-		if fromContact != nil && toContact != nil {
-			break
+	withRules := slices.Clone(validators)
+	for _, rule := range domainRules {
+		if rule.Rule.Scope.Covers(scope) {
+			withRules = append(withRules, s.ruleValidator(rule))
 		}
+	}
 
-		if contact == nil {
-			continue
-		}
+	return withRules, nil
+}
+
+func (s *contactPrivacyService) ruleValidator(rule *rules.Compiled) ValidationFunc {
+	return func(from, to *model.Contact, toSettings *model.ContactSettings) error {
+		allowed, err := rule.Eval(from, to, toSettings)
+		if err != nil {
+			s.logger.Warn("evaluating privacy rule", "error", err, "rule_id", rule.Rule.ID, "rule", rule.Rule.Name)
 
-		if contact.ID == from {
-			fromContact = contact
+			return model.NewDenialError(model.DenialReasonCustomRule, errors.Internal("evaluating privacy rule "+rule.Rule.Name, errors.WithCause(err)))
 		}
 
-		if contact.ID == to {
-			toContact = contact
+		if !allowed {
+			return model.NewDenialError(model.DenialReasonCustomRule, errors.Forbidden("denied by privacy rule "+rule.Rule.Name))
 		}
+
+		return nil
 	}
+}
+
+func newPrivacyDecision(to uuid.UUID, err error) *model.PrivacyDecision {
+	decision := &model.PrivacyDecision{To: to, Allowed: err == nil, Cause: err}
 
-	if fromContact == nil || toContact == nil {
-		return nil, nil, errors.InvalidArgument("can't find contacts to validate")
+	var denial *model.DenialError
+	if errors.As(err, &denial) {
+		decision.Reason = denial.Reason
 	}
 
-	return fromContact, toContact, nil
+	return decision
 }
 
 func (s *contactPrivacyService) checkValidationRules(from, to *model.Contact, toSettings *model.ContactSettings, validators []ValidationFunc) error {
@@ -147,25 +317,33 @@ func (s *contactPrivacyService) checkValidationRules(from, to *model.Contact, to
 	return nil
 }
 
-func (s *contactPrivacyService) validateCanInvite(fromContact, toContact *model.Contact, toSettings *model.ContactSettings) error {
-	return s.checkValidationRules(fromContact, toContact, toSettings, inviteValidators)
-}
+func validateAllowInviteFrom(from, to *model.Contact, toSettings *model.ContactSettings) error {
+	if toSettings == nil {
+		return errors.InvalidArgument("receiver settings required")
+	}
+
+	userFilter := toSettings.AllowInvitesFrom
+
+	allow := userFilter.InFilter(from, to, toSettings.AllowedContacts)
 
-func (s *contactPrivacyService) validateCanSend(fromContact, toContact *model.Contact, toSettings *model.ContactSettings) error {
-	return s.checkValidationRules(fromContact, toContact, toSettings, sendValidators)
+	if !allow {
+		return model.NewDenialError(model.DenialReasonPrivacySettings, errors.Forbidden("receiver privacy settings forbid sending invites"))
+	}
+
+	return nil
 }
 
-func validateAllowInviteFrom(from, to *model.Contact, toSettings *model.ContactSettings) error {
+func validateAllowMessagesFrom(from, to *model.Contact, toSettings *model.ContactSettings) error {
 	if toSettings == nil {
 		return errors.InvalidArgument("receiver settings required")
 	}
 
-	userFilter := toSettings.AllowInvitesFrom
+	userFilter := toSettings.AllowMessagesFrom
 
-	allow := userFilter.InFilter(from, to)
+	allow := userFilter.InFilter(from, to, toSettings.AllowedContacts)
 
 	if !allow {
-		return errors.Forbidden("receiver privacy settings forbid sending invites")
+		return model.NewDenialError(model.DenialReasonPrivacySettings, errors.Forbidden("receiver privacy settings forbid sending messages"))
 	}
 
 	return nil
@@ -177,7 +355,7 @@ func ensureSharedDomain(from, to *model.Contact, _ *model.ContactSettings) error
 	}
 
 	if from.DomainID != to.DomainID {
-		return errors.InvalidArgument("contacts should share a domain")
+		return model.NewDenialError(model.DenialReasonDifferentDomain, errors.InvalidArgument("contacts should share a domain"))
 	}
 
 	return nil
@@ -189,7 +367,7 @@ func denyBotToBotCommunication(from, to *model.Contact, _ *model.ContactSettings
 	}
 
 	if from.IsBot && to.IsBot {
-		return errors.Forbidden("bot to bot communication is forbidden")
+		return model.NewDenialError(model.DenialReasonBotToBot, errors.Forbidden("bot to bot communication is forbidden"))
 	}
 
 	return nil
@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/rules"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+var _ PrivacyRuleService = &privacyRuleService{}
+
+type privacyRuleService struct {
+	logger *slog.Logger
+	store  store.PrivacyRuleStore
+	rules  *rules.Engine
+	access access
+}
+
+func NewPrivacyRuleService(log *slog.Logger, store store.PrivacyRuleStore, rules *rules.Engine) PrivacyRuleService {
+	return &privacyRuleService{logger: log.With("component", "privacy_rule_service"), store: store, rules: rules}
+}
+
+func (s *privacyRuleService) Create(ctx context.Context, rule *model.PrivacyRule) (*model.PrivacyRule, error) {
+	if rule == nil {
+		return nil, errors.InvalidArgument("privacy rule required")
+	}
+
+	domainID, err := s.access.manageDomain(ctx, rule.DomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.DomainID = domainID
+
+	if rule.DomainID <= 0 {
+		return nil, errors.InvalidArgument("domain id required")
+	}
+
+	if rule.Name == "" {
+		return nil, errors.InvalidArgument("privacy rule name required")
+	}
+
+	if !rule.Scope.Valid() {
+		return nil, errors.InvalidArgument("unknown privacy rule scope")
+	}
+
+	if _, err := s.rules.Compile(rule.Expression); err != nil {
+		return nil, err
+	}
+
+	created, err := s.store.Create(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	s.rules.Invalidate(rule.DomainID)
+
+	return created, nil
+}
+
+func (s *privacyRuleService) List(ctx context.Context, domainID int) ([]*model.PrivacyRule, error) {
+	domainID, err := s.access.manageDomain(ctx, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if domainID <= 0 {
+		return nil, errors.InvalidArgument("domain id required")
+	}
+
+	return s.store.List(ctx, domainID)
+}
+
+func (s *privacyRuleService) Delete(ctx context.Context, domainID int, id uuid.UUID) error {
+	domainID, err := s.access.manageDomain(ctx, domainID)
+	if err != nil {
+		return err
+	}
+
+	if domainID <= 0 {
+		return errors.InvalidArgument("domain id required")
+	}
+
+	if id == uuid.Nil {
+		return errors.InvalidArgument("privacy rule id required")
+	}
+
+	if err := s.store.Delete(ctx, domainID, id); err != nil {
+		return err
+	}
+
+	s.rules.Invalidate(domainID)
+
+	return nil
+}
@@ -0,0 +1,301 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/infra/auth"
+	"github.com/webitel/im-contact-service/infra/ratelimit"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/rules"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+type fakeContactStore struct {
+	store.ContactStore
+
+	mu       sync.Mutex
+	contacts map[uuid.UUID]*model.Contact
+	lookups  [][]uuid.UUID
+}
+
+func (f *fakeContactStore) FindByIDs(_ context.Context, ids []uuid.UUID) ([]*model.Contact, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.lookups = append(f.lookups, ids)
+
+	found := make([]*model.Contact, 0, len(ids))
+	for _, id := range ids {
+		if c, ok := f.contacts[id]; ok {
+			found = append(found, c)
+		}
+	}
+
+	return found, nil
+}
+
+type fakeSettingsStore struct {
+	store.SettingsStore
+
+	settings map[uuid.UUID]*model.ContactSettings
+}
+
+func (f *fakeSettingsStore) GetMany(_ context.Context, ids []uuid.UUID) ([]*model.ContactSettings, error) {
+	found := make([]*model.ContactSettings, 0, len(ids))
+	for _, id := range ids {
+		if s, ok := f.settings[id]; ok {
+			found = append(found, s)
+		}
+	}
+
+	return found, nil
+}
+
+type fakePrivacyRuleStore struct {
+	store.PrivacyRuleStore
+}
+
+func (fakePrivacyRuleStore) List(context.Context, int) ([]*model.PrivacyRule, error) {
+	return nil, nil
+}
+
+type privacyFixture struct {
+	service  ContactPrivacyService
+	contacts *fakeContactStore
+	settings *fakeSettingsStore
+}
+
+func newPrivacyFixture(t *testing.T, limiter ratelimit.InviteLimiter) *privacyFixture {
+	t.Helper()
+
+	engine, err := rules.NewEngine(fakePrivacyRuleStore{}, slog.New(slog.DiscardHandler))
+	if err != nil {
+		t.Fatalf("rules engine: %v", err)
+	}
+
+	f := &privacyFixture{
+		contacts: &fakeContactStore{contacts: make(map[uuid.UUID]*model.Contact)},
+		settings: &fakeSettingsStore{settings: make(map[uuid.UUID]*model.ContactSettings)},
+	}
+
+	f.service, err = NewContactPrivacyService(slog.New(slog.DiscardHandler), f.settings, f.contacts, engine, limiter)
+	if err != nil {
+		t.Fatalf("privacy service: %v", err)
+	}
+
+	return f
+}
+
+// contact adds a contact of domain 1 whose settings let everyone reach it unless filter says otherwise.
+func (f *privacyFixture) contact(filter model.UserFilter) uuid.UUID {
+	id := uuid.New()
+	f.contacts.contacts[id] = &model.Contact{BaseModel: model.BaseModel{ID: id, DomainID: 1}}
+	f.settings.settings[id] = &model.ContactSettings{ContactID: id, AllowInvitesFrom: filter, AllowMessagesFrom: filter}
+
+	return id
+}
+
+func serviceContext() context.Context {
+	return auth.NewContext(context.Background(), &auth.Identity{DomainID: 1, Service: true})
+}
+
+func TestBatchCanSendDecisions(t *testing.T) {
+	f := newPrivacyFixture(t, ratelimit.Unlimited{})
+
+	var (
+		from    = f.contact(model.All)
+		open    = f.contact(model.All)
+		closed  = f.contact(model.Nobody)
+		missing = uuid.New()
+	)
+
+	decisions, err := f.service.BatchCanSend(serviceContext(), &model.BatchCanSendRequest{
+		From: from,
+		To:   []uuid.UUID{open, closed, missing, open},
+	})
+	if err != nil {
+		t.Fatalf("batch can send: %v", err)
+	}
+
+	want := []struct {
+		to      uuid.UUID
+		allowed bool
+		reason  model.DenialReason
+	}{
+		{open, true, model.DenialReasonUnspecified},
+		{closed, false, model.DenialReasonPrivacySettings},
+		{missing, false, model.DenialReasonContactNotFound},
+		{open, true, model.DenialReasonUnspecified},
+	}
+
+	if len(decisions) != len(want) {
+		t.Fatalf("got %d decisions, want %d", len(decisions), len(want))
+	}
+
+	for i, w := range want {
+		d := decisions[i]
+		if d.To != w.to || d.Allowed != w.allowed || d.Reason != w.reason {
+			t.Fatalf("decision %d = {%s %v %v}, want {%s %v %v}", i, d.To, d.Allowed, d.Reason, w.to, w.allowed, w.reason)
+		}
+	}
+
+	// the first lookup is the access check of the sender
+	if len(f.contacts.lookups) != 2 || len(f.contacts.lookups[1]) != 4 {
+		t.Fatalf("contacts must be loaded once without duplicates, got %v", f.contacts.lookups)
+	}
+}
+
+func TestBatchChecksRejectOversizedBatches(t *testing.T) {
+	f := newPrivacyFixture(t, ratelimit.Unlimited{})
+	from := f.contact(model.All)
+
+	to := make([]uuid.UUID, model.MaxPrivacyBatchSize+1)
+	for i := range to {
+		to[i] = uuid.New()
+	}
+
+	f.contacts.lookups = nil
+
+	if _, err := f.service.BatchCanSend(serviceContext(), &model.BatchCanSendRequest{From: from, To: to}); errors.Code(err) != codes.InvalidArgument {
+		t.Fatalf("batch can send: err = %v, want InvalidArgument", err)
+	}
+
+	if _, err := f.service.BatchCanInvite(serviceContext(), &model.BatchCanInviteRequest{From: from, To: to}); errors.Code(err) != codes.InvalidArgument {
+		t.Fatalf("batch can invite: err = %v, want InvalidArgument", err)
+	}
+
+	if len(f.contacts.lookups) != 0 {
+		t.Fatalf("oversized batches must not reach the store, got %d lookups", len(f.contacts.lookups))
+	}
+}
+
+func TestBatchCanInviteRateLimited(t *testing.T) {
+	f := newPrivacyFixture(t, ratelimit.NewMemory(ratelimit.Bucket{Capacity: 2, Window: time.Hour}))
+
+	var (
+		from = f.contact(model.All)
+		a    = f.contact(model.All)
+		b    = f.contact(model.All)
+	)
+
+	decisions, err := f.service.BatchCanInvite(serviceContext(), &model.BatchCanInviteRequest{From: from, To: []uuid.UUID{a, b}})
+	if err != nil {
+		t.Fatalf("first batch: %v", err)
+	}
+
+	for i, d := range decisions {
+		if !d.Allowed {
+			t.Fatalf("first batch: decision %d denied with %v", i, d.Reason)
+		}
+	}
+
+	decisions, err = f.service.BatchCanInvite(serviceContext(), &model.BatchCanInviteRequest{From: from, To: []uuid.UUID{a}})
+	if err != nil {
+		t.Fatalf("second batch: %v", err)
+	}
+
+	if d := decisions[0]; d.Allowed || d.Reason != model.DenialReasonRateLimited || d.RetryAfter <= 0 {
+		t.Fatalf("second batch = {%v %v %v}, want rate limited with a retry delay", d.Allowed, d.Reason, d.RetryAfter)
+	}
+}
+
+func TestBatchCanInviteChargesDistinctRecipients(t *testing.T) {
+	f := newPrivacyFixture(t, ratelimit.NewMemory(ratelimit.Bucket{Capacity: 2, Window: time.Hour}))
+
+	var (
+		from = f.contact(model.All)
+		a    = f.contact(model.All)
+		b    = f.contact(model.All)
+	)
+
+	// three entries for one recipient cost a single token
+	decisions, err := f.service.BatchCanInvite(serviceContext(), &model.BatchCanInviteRequest{From: from, To: []uuid.UUID{a, a, a}})
+	if err != nil {
+		t.Fatalf("duplicated batch: %v", err)
+	}
+
+	if len(decisions) != 3 || !decisions[0].Allowed {
+		t.Fatalf("duplicated batch: got %d decisions, want 3 allowed", len(decisions))
+	}
+
+	if decisions, err = f.service.BatchCanInvite(serviceContext(), &model.BatchCanInviteRequest{From: from, To: []uuid.UUID{b}}); err != nil || !decisions[0].Allowed {
+		t.Fatalf("expected a token left after the duplicated batch, err = %v", err)
+	}
+
+	_, err = f.service.BatchCanInvite(serviceContext(), &model.BatchCanInviteRequest{From: from, To: []uuid.UUID{a, b, f.contact(model.All)}})
+	if errors.Code(err) != codes.InvalidArgument {
+		t.Fatalf("batch above the limit: err = %v, want InvalidArgument", err)
+	}
+}
+
+func TestPrivacyCheckFailsWithoutRecipientSettings(t *testing.T) {
+	f := newPrivacyFixture(t, ratelimit.Unlimited{})
+
+	var (
+		from = f.contact(model.All)
+		to   = f.contact(model.All)
+	)
+
+	delete(f.settings.settings, to)
+
+	_, err := f.service.CanSend(serviceContext(), &model.CanSendRequest{From: from, To: to})
+	if errors.Code(err) != codes.InvalidArgument {
+		t.Fatalf("can send: err = %v, want InvalidArgument", err)
+	}
+
+	_, err = f.service.BatchCanSend(serviceContext(), &model.BatchCanSendRequest{From: from, To: []uuid.UUID{to}})
+	if errors.Code(err) != codes.InvalidArgument {
+		t.Fatalf("batch can send: err = %v, want InvalidArgument", err)
+	}
+}
+
+func TestPrivacyCheckSenderAmongRecipients(t *testing.T) {
+	f := newPrivacyFixture(t, ratelimit.NewMemory(ratelimit.Bucket{Capacity: 2, Window: time.Hour}))
+
+	var (
+		from   = f.contact(model.All)
+		closed = f.contact(model.Nobody)
+	)
+
+	decisions, err := f.service.BatchCanSend(serviceContext(), &model.BatchCanSendRequest{From: from, To: []uuid.UUID{closed, from}})
+	if err != nil {
+		t.Fatalf("batch can send: %v", err)
+	}
+
+	if decisions[0].Allowed || !decisions[1].Allowed {
+		t.Fatalf("batch can send = [%v %v], want [false true]", decisions[0].Allowed, decisions[1].Allowed)
+	}
+
+	invites, err := f.service.BatchCanInvite(serviceContext(), &model.BatchCanInviteRequest{From: from, To: []uuid.UUID{from, closed}})
+	if err != nil {
+		t.Fatalf("batch can invite: %v", err)
+	}
+
+	if !invites[0].Allowed || invites[1].Allowed {
+		t.Fatalf("batch can invite = [%v %v], want [true false]", invites[0].Allowed, invites[1].Allowed)
+	}
+}
+
+func TestPrivacyCheckSenderToItself(t *testing.T) {
+	f := newPrivacyFixture(t, ratelimit.NewMemory(ratelimit.Bucket{Capacity: 1, Window: time.Hour}))
+	from := f.contact(model.All)
+
+	sent, err := f.service.CanSend(serviceContext(), &model.CanSendRequest{From: from, To: from})
+	if err != nil || !sent.Allowed {
+		t.Fatalf("can send to itself: decision = %+v, err = %v", sent, err)
+	}
+
+	invited, err := f.service.CanInvite(serviceContext(), &model.CanInviteRequest{From: from, To: from})
+	if err != nil || !invited.Allowed {
+		t.Fatalf("can invite itself: decision = %+v, err = %v", invited, err)
+	}
+}
@@ -3,11 +3,14 @@ package service
 import (
 	"context"
 
+	"github.com/google/uuid"
 	"go.uber.org/fx"
 
 	pubsubadapter "github.com/webitel/im-contact-service/internal/adapter/pubsub"
 	"github.com/webitel/im-contact-service/internal/handler/amqp"
+	"github.com/webitel/im-contact-service/internal/handler/scheduler"
 	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/rules"
 )
 
 type ContactSettingsService interface {
@@ -16,6 +19,18 @@ type ContactSettingsService interface {
 	Create(ctx context.Context, request *model.CreateContactSettingsRequest) (*model.ContactSettings, error)
 }
 
+type SettingsTemplateService interface {
+	Get(ctx context.Context, domainID int) (*model.SettingsTemplate, error)
+	Set(ctx context.Context, template *model.SettingsTemplate) (*model.SettingsTemplate, error)
+	Delete(ctx context.Context, domainID int) error
+}
+
+type PrivacyRuleService interface {
+	Create(ctx context.Context, rule *model.PrivacyRule) (*model.PrivacyRule, error)
+	List(ctx context.Context, domainID int) ([]*model.PrivacyRule, error)
+	Delete(ctx context.Context, domainID int, id uuid.UUID) error
+}
+
 type ContactService interface {
 	Search(ctx context.Context, filter *model.ContactSearchRequest) ([]*model.Contact, error)
 	Create(ctx context.Context, input *model.Contact) (*model.Contact, error)
@@ -26,11 +41,14 @@ type ContactService interface {
 	DeleteByDomain(ctx context.Context, domainID int) error
 	DeleteBotByFlowID(ctx context.Context, flowID string) error
 	Locate(ctx context.Context, locate *model.LocateContactRequest) (*model.Contact, error)
+	ResolveByVia(ctx context.Context, request *model.ResolveByViaRequest) ([]*model.ResolvedContact, error)
 }
 
 type ContactPrivacyService interface {
-	CanSend(ctx context.Context, query *model.CanSendRequest) error
-	CanInvite(ctx context.Context, query *model.CanInviteRequest) error
+	CanSend(ctx context.Context, query *model.CanSendRequest) (*model.PrivacyDecision, error)
+	CanInvite(ctx context.Context, query *model.CanInviteRequest) (*model.PrivacyDecision, error)
+	BatchCanSend(ctx context.Context, query *model.BatchCanSendRequest) ([]*model.PrivacyDecision, error)
+	BatchCanInvite(ctx context.Context, query *model.BatchCanInviteRequest) ([]*model.PrivacyDecision, error)
 }
 
 type ViaService interface {
@@ -38,6 +56,14 @@ type ViaService interface {
 	Update(ctx context.Context, communication *model.ViaCommunication) (*model.ViaCommunication, error)
 	PartialUpdate(ctx context.Context, updateCommand *model.CommunicationViaPartialUpdateCmd) (*model.ViaCommunication, error)
 	Search(ctx context.Context, filter *model.SearchViaCommunicationsFilter) ([]*model.ViaCommunication, error)
+	Delete(ctx context.Context, deleteCommand *model.DeleteViaCommand) (*model.ViaCommunication, error)
+	DeleteByContact(ctx context.Context, contactID uuid.UUID) ([]*model.ViaCommunication, error)
+	Replace(ctx context.Context, command *model.ReplaceViasCommand) ([]*model.ViaCommunication, error)
+	StartVerification(ctx context.Context, command *model.StartViaVerificationCommand) (*model.ViaVerification, error)
+	ConfirmVerification(ctx context.Context, command *model.ConfirmViaVerificationCommand) (*model.ViaCommunication, error)
+	SetPrimary(ctx context.Context, command *model.SetPrimaryViaCommand) (*model.ViaCommunication, error)
+	ChangeConsent(ctx context.Context, command *model.ChangeViaConsentCommand) (*model.ViaConsent, error)
+	GetConsent(ctx context.Context, request *model.GetViaConsentRequest) (*model.ViaConsentState, error)
 }
 
 var Module = fx.Module("service",
@@ -61,10 +87,21 @@ var Module = fx.Module("service",
 			fx.As(new(amqp.DomainEventsHandler)),
 			fx.As(fx.Self()),
 		),
-		fx.Annotate(newCommunication, fx.As(new(ViaService))),
+		ProvideVerificationPolicy,
+		ProvideDeliveryFailurePolicy,
+		fx.Annotate(
+			newCommunication,
+			fx.As(new(ViaService)),
+			fx.As(new(amqp.DeliveryEventsHandler)),
+			fx.As(new(scheduler.SuspensionHandler)),
+		),
 		NewContactSettingService,
+		NewSettingsTemplateService,
 		NewContactPrivacyService,
+		rules.NewEngine,
+		NewPrivacyRuleService,
 	),
 
 	fx.Invoke(amqp.RegisterHandlers),
+	fx.Invoke(scheduler.RunSuspensionScheduler),
 )
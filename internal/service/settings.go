@@ -8,6 +8,7 @@ import (
 
 	"github.com/webitel/webitel-go-kit/pkg/errors"
 
+	"github.com/webitel/im-contact-service/internal/domain/events"
 	"github.com/webitel/im-contact-service/internal/model"
 	"github.com/webitel/im-contact-service/internal/store"
 )
@@ -17,10 +18,27 @@ var _ ContactSettingsService = &contactSettingsService{}
 type contactSettingsService struct {
 	logger        *slog.Logger
 	settingsStore store.SettingsStore
+	contactStore  store.ContactStore
+	templateStore store.SettingsTemplateStore
+	publisher     EventPublisher
+	access        access
 }
 
-func NewContactSettingService(log *slog.Logger, store store.SettingsStore) (ContactSettingsService, error) {
-	return &contactSettingsService{logger: log, settingsStore: store}, nil
+func NewContactSettingService(
+	log *slog.Logger,
+	settingsStore store.SettingsStore,
+	contactStore store.ContactStore,
+	templateStore store.SettingsTemplateStore,
+	publisher EventPublisher,
+) (ContactSettingsService, error) {
+	return &contactSettingsService{
+		logger:        log,
+		settingsStore: settingsStore,
+		contactStore:  contactStore,
+		templateStore: templateStore,
+		publisher:     publisher,
+		access:        newAccess(contactStore),
+	}, nil
 }
 
 func (s *contactSettingsService) Get(ctx context.Context, req *model.GetContactSettingsRequest) (*model.ContactSettings, error) {
@@ -32,6 +50,10 @@ func (s *contactSettingsService) Get(ctx context.Context, req *model.GetContactS
 		return nil, errors.InvalidArgument("contact id required to get settings")
 	}
 
+	if err := s.access.contact(ctx, req.ContactID); err != nil {
+		return nil, err
+	}
+
 	if req.InitiatorContactID != uuid.Nil && req.InitiatorContactID != req.ContactID {
 		return nil, errors.Forbidden("contact can get only own settings")
 	}
@@ -48,11 +70,68 @@ func (s *contactSettingsService) Update(ctx context.Context, request *model.Upda
 		return nil, errors.InvalidArgument("contact id required to update settings")
 	}
 
+	if err := s.access.contact(ctx, request.ContactID); err != nil {
+		return nil, err
+	}
+
 	if request.InitiatorContactID != uuid.Nil && request.InitiatorContactID != request.ContactID {
 		return nil, errors.Forbidden("contact can change only own settings")
 	}
 
-	return s.settingsStore.Update(ctx, request)
+	if request.AllowInvitesFrom != nil && !request.AllowInvitesFrom.Valid() {
+		return nil, errors.InvalidArgument("unknown allow invites from filter")
+	}
+
+	if request.AllowMessagesFrom != nil && !request.AllowMessagesFrom.Valid() {
+		return nil, errors.InvalidArgument("unknown allow messages from filter")
+	}
+
+	if err := s.checkLockedFields(ctx, request); err != nil {
+		return nil, err
+	}
+
+	request.UpdatedBy = s.access.actor(ctx)
+
+	// the store returns the values this very update replaced, so concurrent updates never publish wrong old values
+	old, updated, err := s.settingsStore.Update(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.publisher.Publish(ctx, events.NewSettingsUpdated(old, updated)); err != nil {
+		s.logger.Error("publishing settings updated event", "error", err, "contact_id", request.ContactID)
+
+		return updated, errors.Internal("publishing settings updated event", errors.WithCause(err), errors.WithID("service.settings.update"))
+	}
+
+	return updated, nil
+}
+
+// checkLockedFields rejects changes to the fields locked by the contact's domain template.
+func (s *contactSettingsService) checkLockedFields(ctx context.Context, request *model.UpdateContactSettingsRequest) error {
+	if request.AllowInvitesFrom == nil && request.AllowMessagesFrom == nil && request.Discoverable == nil {
+		return nil
+	}
+
+	contacts, err := s.contactStore.FindByIDs(ctx, []uuid.UUID{request.ContactID})
+	if err != nil {
+		return err
+	}
+
+	if len(contacts) == 0 {
+		return errors.NotFound("contact doesn`t exist", errors.WithID("service.settings.check_locked_fields"))
+	}
+
+	template, err := s.templateStore.Get(ctx, contacts[0].DomainID)
+	if err != nil {
+		return err
+	}
+
+	if field, locked := template.CheckLocked(request); locked {
+		return errors.Forbidden("setting is locked by domain policy: "+field, errors.WithID("service.settings.check_locked_fields"))
+	}
+
+	return nil
 }
 
 func (s *contactSettingsService) Create(ctx context.Context, request *model.CreateContactSettingsRequest) (*model.ContactSettings, error) {
@@ -68,5 +147,40 @@ func (s *contactSettingsService) Create(ctx context.Context, request *model.Crea
 		return nil, errors.InvalidArgument("contact id required to update settings")
 	}
 
-	return s.settingsStore.Create(ctx, request)
+	if err := s.access.contact(ctx, request.ContactID); err != nil {
+		return nil, err
+	}
+
+	if !request.Settings.AllowInvitesFrom.Valid() {
+		return nil, errors.InvalidArgument("unknown allow invites from filter")
+	}
+
+	if !request.Settings.AllowMessagesFrom.Valid() {
+		return nil, errors.InvalidArgument("unknown allow messages from filter")
+	}
+
+	// created settings may not start with values the domain template locks either
+	if err := s.checkLockedFields(ctx, &model.UpdateContactSettingsRequest{
+		ContactID:         request.ContactID,
+		AllowInvitesFrom:  &request.Settings.AllowInvitesFrom,
+		AllowMessagesFrom: &request.Settings.AllowMessagesFrom,
+		Discoverable:      request.Discoverable,
+	}); err != nil {
+		return nil, err
+	}
+
+	request.CreatedBy = s.access.actor(ctx)
+
+	created, err := s.settingsStore.Create(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.publisher.Publish(ctx, events.NewSettingsCreated(created)); err != nil {
+		s.logger.Error("publishing settings created event", "error", err, "contact_id", request.ContactID)
+
+		return created, errors.Internal("publishing settings created event", errors.WithCause(err), errors.WithID("service.settings.create"))
+	}
+
+	return created, nil
 }
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/internal/domain/events"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+var _ SettingsTemplateService = &settingsTemplateService{}
+
+type settingsTemplateService struct {
+	logger    *slog.Logger
+	store     store.SettingsTemplateStore
+	publisher EventPublisher
+	access    access
+}
+
+func NewSettingsTemplateService(log *slog.Logger, store store.SettingsTemplateStore, publisher EventPublisher) SettingsTemplateService {
+	return &settingsTemplateService{logger: log.With("component", "settings_template_service"), store: store, publisher: publisher}
+}
+
+func (s *settingsTemplateService) Get(ctx context.Context, domainID int) (*model.SettingsTemplate, error) {
+	domainID, err := s.access.domain(ctx, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if domainID <= 0 {
+		return nil, errors.InvalidArgument("domain id required")
+	}
+
+	template, err := s.store.Get(ctx, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if template == nil {
+		return nil, errors.NotFound("settings template doesn`t exist", errors.WithID("service.settings_template.get"))
+	}
+
+	return template, nil
+}
+
+func (s *settingsTemplateService) Set(ctx context.Context, template *model.SettingsTemplate) (*model.SettingsTemplate, error) {
+	if template == nil {
+		return nil, errors.InvalidArgument("settings template required")
+	}
+
+	domainID, err := s.access.manageDomain(ctx, template.DomainID)
+	if err != nil {
+		return nil, err
+	}
+
+	template.DomainID = domainID
+
+	if template.DomainID <= 0 {
+		return nil, errors.InvalidArgument("domain id required")
+	}
+
+	if !template.AllowInvitesFrom.Valid() {
+		return nil, errors.InvalidArgument("unknown allow invites from filter")
+	}
+
+	if !template.AllowMessagesFrom.Valid() {
+		return nil, errors.InvalidArgument("unknown allow messages from filter")
+	}
+
+	for _, field := range template.LockedFields {
+		if !slices.Contains(model.LockableSettingsFields(), field) {
+			return nil, errors.InvalidArgument("unknown locked field: " + field)
+		}
+	}
+
+	saved, enforced, err := s.store.Upsert(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(enforced) > 0 {
+		s.logger.Info("locked settings enforced on existing contacts", "domain_id", saved.DomainID, "contacts", len(enforced))
+	}
+
+	// enforced values are settings updates like any other for the consumers of the contacts settings
+	for _, change := range enforced {
+		if err := s.publisher.Publish(ctx, events.NewSettingsUpdated(change.Old, change.Updated)); err != nil {
+			s.logger.Error("publishing settings updated event", "error", err, "contact_id", change.Updated.ContactID)
+
+			return saved, errors.Internal("publishing settings updated event", errors.WithCause(err), errors.WithID("service.settings_template.set"))
+		}
+	}
+
+	return saved, nil
+}
+
+func (s *settingsTemplateService) Delete(ctx context.Context, domainID int) error {
+	domainID, err := s.access.manageDomain(ctx, domainID)
+	if err != nil {
+		return err
+	}
+
+	if domainID <= 0 {
+		return errors.InvalidArgument("domain id required")
+	}
+
+	return s.store.Delete(ctx, domainID)
+}
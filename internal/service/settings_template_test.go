@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/im-contact-service/internal/domain/events"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+// fakeTemplateStore keeps one template per domain and reports the configured settings as enforced on upsert.
+type fakeTemplateStore struct {
+	store.SettingsTemplateStore
+
+	templates map[int]*model.SettingsTemplate
+	enforced  []*model.SettingsChange
+}
+
+func (f *fakeTemplateStore) Get(_ context.Context, domainID int) (*model.SettingsTemplate, error) {
+	return f.templates[domainID], nil
+}
+
+func (f *fakeTemplateStore) Upsert(_ context.Context, template *model.SettingsTemplate) (*model.SettingsTemplate, []*model.SettingsChange, error) {
+	f.templates[template.DomainID] = template
+
+	return template, f.enforced, nil
+}
+
+func TestSetTemplatePublishesEnforcedSettings(t *testing.T) {
+	contactID := uuid.New()
+
+	templates := &fakeTemplateStore{
+		templates: make(map[int]*model.SettingsTemplate),
+		enforced: []*model.SettingsChange{{
+			Old:     &model.ContactSettings{ContactID: contactID, AllowMessagesFrom: model.All},
+			Updated: &model.ContactSettings{ContactID: contactID, AllowMessagesFrom: model.Nobody},
+		}},
+	}
+	publisher := &fakePublisher{}
+
+	service := NewSettingsTemplateService(slog.New(slog.DiscardHandler), templates, publisher)
+
+	_, err := service.Set(serviceContext(), &model.SettingsTemplate{
+		AllowInvitesFrom:  model.All,
+		AllowMessagesFrom: model.Nobody,
+		LockedFields:      []string{model.SettingsFieldAllowMessagesFrom},
+	})
+	if err != nil {
+		t.Fatalf("setting template: %v", err)
+	}
+
+	if len(publisher.events) != 1 {
+		t.Fatalf("published %d events, want one per enforced contact", len(publisher.events))
+	}
+
+	updated, ok := publisher.events[0].(*events.SettingsUpdated)
+	if !ok {
+		t.Fatalf("published %T, want settings updated", publisher.events[0])
+	}
+
+	if updated.ContactID != contactID || updated.Old.AllowMessagesFrom != model.All || updated.New.AllowMessagesFrom != model.Nobody {
+		t.Fatalf("settings updated = %s %v -> %v, want %s %v -> %v",
+			updated.ContactID, updated.Old.AllowMessagesFrom, updated.New.AllowMessagesFrom, contactID, model.All, model.Nobody)
+	}
+}
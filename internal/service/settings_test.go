@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/internal/model"
+)
+
+func (f *fakeSettingsStore) Create(_ context.Context, request *model.CreateContactSettingsRequest) (*model.ContactSettings, error) {
+	created := *request.Settings
+	created.ContactID = request.ContactID
+	f.settings[request.ContactID] = &created
+
+	return &created, nil
+}
+
+func TestCreateSettingsChecksFiltersAndLocks(t *testing.T) {
+	contactID := uuid.New()
+
+	contacts := &fakeContactStore{contacts: map[uuid.UUID]*model.Contact{
+		contactID: {BaseModel: model.BaseModel{ID: contactID, DomainID: 1}},
+	}}
+	templates := &fakeTemplateStore{templates: map[int]*model.SettingsTemplate{
+		1: {DomainID: 1, AllowMessagesFrom: model.Nobody, LockedFields: []string{model.SettingsFieldAllowMessagesFrom}},
+	}}
+	settings := &fakeSettingsStore{settings: make(map[uuid.UUID]*model.ContactSettings)}
+
+	service, err := NewContactSettingService(slog.New(slog.DiscardHandler), settings, contacts, templates, &fakePublisher{})
+	if err != nil {
+		t.Fatalf("settings service: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		settings model.ContactSettings
+		want     codes.Code
+	}{
+		{name: "unknown invites filter", settings: model.ContactSettings{AllowInvitesFrom: model.SameContactType + 1, AllowMessagesFrom: model.Nobody}, want: codes.InvalidArgument},
+		{name: "unknown messages filter", settings: model.ContactSettings{AllowMessagesFrom: -1}, want: codes.InvalidArgument},
+		{name: "locked field overridden", settings: model.ContactSettings{AllowMessagesFrom: model.All}, want: codes.PermissionDenied},
+		{name: "locked field kept", settings: model.ContactSettings{AllowInvitesFrom: model.NonBots, AllowMessagesFrom: model.Nobody}, want: codes.OK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := service.Create(serviceContext(), &model.CreateContactSettingsRequest{ContactID: contactID, Settings: &tt.settings})
+			if errors.Code(err) != tt.want {
+				t.Fatalf("create: err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if created := settings.settings[contactID]; created == nil || created.AllowInvitesFrom != model.NonBots {
+		t.Fatalf("only the valid settings must be stored, got %+v", created)
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"context"
 	"log/slog"
 
+	"github.com/google/uuid"
+
 	"github.com/webitel/webitel-go-kit/pkg/errors"
 
+	"github.com/webitel/im-contact-service/infra/db/pg"
 	"github.com/webitel/im-contact-service/internal/domain/events"
 	"github.com/webitel/im-contact-service/internal/model"
 	"github.com/webitel/im-contact-service/internal/store"
@@ -14,13 +17,36 @@ import (
 type via struct {
 	publisher          EventPublisher
 	communicationStore store.ViaStore
+	verificationStore  store.ViaVerificationStore
+	consentStore       store.ViaConsentStore
+	access             access
+	verificationPolicy model.VerificationPolicy
+	failurePolicy      model.DeliveryFailurePolicy
 	logger             *slog.Logger
 }
 
-func newCommunication(logger *slog.Logger, communicationStore store.ViaStore, publisher EventPublisher) *via {
+func newCommunication(
+	logger *slog.Logger,
+	communicationStore store.ViaStore,
+	verificationStore store.ViaVerificationStore,
+	consentStore store.ViaConsentStore,
+	contactStore store.ContactStore,
+	verificationPolicy model.VerificationPolicy,
+	failurePolicy model.DeliveryFailurePolicy,
+	publisher EventPublisher,
+) *via {
 	log := logger.With("component", "service.communication")
 
-	return &via{logger: log, communicationStore: communicationStore, publisher: publisher}
+	return &via{
+		logger:             log,
+		communicationStore: communicationStore,
+		verificationStore:  verificationStore,
+		consentStore:       consentStore,
+		access:             newAccess(contactStore),
+		verificationPolicy: verificationPolicy.WithDefaults(),
+		failurePolicy:      failurePolicy,
+		publisher:          publisher,
+	}
 }
 
 func (communicationService *via) Create(ctx context.Context, communication *model.CreateViaCommunicationCommand) (*model.ViaCommunication, error) {
@@ -32,6 +58,26 @@ func (communicationService *via) Create(ctx context.Context, communication *mode
 		return nil, err
 	}
 
+	// a contact may add vias only to itself, iss + sub are looked up within the caller domain
+	if err := communicationService.access.contact(ctx, communication.ContactID); err != nil {
+		return nil, err
+	}
+
+	domainID, err := communicationService.access.domain(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	communication.DomainID = domainID
+
+	if err := communication.Normalize(); err != nil {
+		log.Warn("normalizing via", "error", err)
+
+		return nil, err
+	}
+
+	communication.CreatedBy = communicationService.access.actor(ctx)
+
 	savedCommunication, err := communicationService.communicationStore.Create(ctx, communication)
 	if err != nil {
 		log.Error(
@@ -62,7 +108,20 @@ func (communicationService *via) Update(ctx context.Context, communication *mode
 		return nil, err
 	}
 
-	updatetCommunication, err := communicationService.communicationStore.Update(ctx, communication)
+	if err := communicationService.access.contact(ctx, communication.ContactID); err != nil {
+		return nil, err
+	}
+
+	stored, err := communicationService.resolveVia(ctx, communication.ContactID, communication.Kind, communication.Via)
+	if err != nil {
+		return nil, err
+	}
+
+	communication.Via = stored.Via
+
+	communication.UpdatedBy = communicationService.access.actor(ctx)
+
+	result, err := communicationService.communicationStore.Update(ctx, communication)
 	if err != nil {
 		log.Error(
 			"full updating contact communication",
@@ -74,12 +133,18 @@ func (communicationService *via) Update(ctx context.Context, communication *mode
 		return nil, err
 	}
 
+	updatetCommunication := result.Via
+
 	if err = communicationService.publisher.Publish(ctx, events.NewViaUpdatedEvent(updatetCommunication)); err != nil {
 		log.Error("publishing via updated event", "error", err, "contact_id", updatetCommunication.ContactID, "new_via", updatetCommunication.Via, "old_via", communication.Via)
 
 		return updatetCommunication, errors.Internal("publishing updaed via event", errors.WithCause(err), errors.WithID("service.communication.update"))
 	}
 
+	if err = communicationService.publishPromoted(ctx, result); err != nil {
+		return updatetCommunication, errors.Internal("publishing promoted via event", errors.WithCause(err), errors.WithID("service.communication.update"))
+	}
+
 	return updatetCommunication, nil
 }
 
@@ -92,19 +157,38 @@ func (communicationService *via) PartialUpdate(ctx context.Context, updateComman
 		return nil, err
 	}
 
-	updated, err := communicationService.communicationStore.PartialUpdate(ctx, updateCommand)
+	if err := communicationService.access.contact(ctx, updateCommand.ContactID); err != nil {
+		return nil, err
+	}
+
+	stored, err := communicationService.resolveVia(ctx, updateCommand.ContactID, updateCommand.Kind, updateCommand.Via)
+	if err != nil {
+		return nil, err
+	}
+
+	updateCommand.Via = stored.Via
+
+	updateCommand.UpdatedBy = communicationService.access.actor(ctx)
+
+	result, err := communicationService.communicationStore.PartialUpdate(ctx, updateCommand)
 	if err != nil {
 		log.Error("partial updating contact communication", "error", err)
 
 		return nil, err
 	}
 
+	updated := result.Via
+
 	if err = communicationService.publisher.Publish(ctx, events.NewViaUpdatedEvent(updated)); err != nil {
 		log.Error("publishing via partially updated event", "error", err, "contact_id", updated.ContactID, "via", updated.Via)
 
 		return updated, errors.Internal("publishing via partially updated event", errors.WithCause(err), errors.WithID("service.communication.partial_update"))
 	}
 
+	if err = communicationService.publishPromoted(ctx, result); err != nil {
+		return updated, errors.Internal("publishing promoted via event", errors.WithCause(err), errors.WithID("service.communication.partial_update"))
+	}
+
 	return updated, nil
 }
 
@@ -117,6 +201,12 @@ func (communicationService *via) Search(ctx context.Context, filter *model.Searc
 		return nil, err
 	}
 
+	if err := communicationService.restrictSearch(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	filter.Normalize()
+
 	records, err := communicationService.communicationStore.Search(ctx, filter)
 	if err != nil {
 		log.Error("retrieving records from store", "error", err)
@@ -126,3 +216,182 @@ func (communicationService *via) Search(ctx context.Context, filter *model.Searc
 
 	return records, nil
 }
+
+func (communicationService *via) Delete(ctx context.Context, deleteCommand *model.DeleteViaCommand) (*model.ViaCommunication, error) {
+	log := communicationService.logger.With("operation", "delete")
+
+	if err := deleteCommand.Validate(); err != nil {
+		log.Warn("validating delete command", "error", err)
+
+		return nil, err
+	}
+
+	if err := communicationService.access.contact(ctx, deleteCommand.ContactID); err != nil {
+		return nil, err
+	}
+
+	stored, err := communicationService.resolveVia(ctx, deleteCommand.ContactID, "", deleteCommand.Via)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteCommand.Via = stored.Via
+	deleteCommand.UpdatedBy = communicationService.access.actor(ctx)
+
+	result, err := communicationService.communicationStore.Delete(ctx, deleteCommand)
+	if err != nil {
+		log.Error("deleting contact communication", "error", err, "contact_id", deleteCommand.ContactID.String(), "via", deleteCommand.Via)
+
+		return nil, err
+	}
+
+	deleted := result.Via
+
+	if err = communicationService.publisher.Publish(ctx, events.NewViaDeletedEvent(deleted)); err != nil {
+		log.Error("publishing via deleted event", "error", err, "contact_id", deleted.ContactID, "via", deleted.Via)
+
+		return deleted, errors.Internal("publishing via deleted event", errors.WithCause(err), errors.WithID("service.communication.delete"))
+	}
+
+	if err = communicationService.publishPromoted(ctx, result); err != nil {
+		return deleted, errors.Internal("publishing promoted via event", errors.WithCause(err), errors.WithID("service.communication.delete"))
+	}
+
+	return deleted, nil
+}
+
+func (communicationService *via) DeleteByContact(ctx context.Context, contactID uuid.UUID) ([]*model.ViaCommunication, error) {
+	log := communicationService.logger.With("operation", "delete_by_contact")
+
+	if contactID == uuid.Nil {
+		return nil, errors.InvalidArgument("contact id is required", errors.WithID("service.communication.delete_by_contact"))
+	}
+
+	if err := communicationService.access.contact(ctx, contactID); err != nil {
+		return nil, err
+	}
+
+	deleted, err := communicationService.communicationStore.DeleteByContact(ctx, contactID)
+	if err != nil {
+		log.Error("deleting contact communications", "error", err, "contact_id", contactID.String())
+
+		return nil, err
+	}
+
+	// every removed via gets its own event so subscribers can keep routing by <contact>.<via>
+	for _, removed := range deleted {
+		if err = communicationService.publisher.Publish(ctx, events.NewViaDeletedEvent(removed)); err != nil {
+			log.Error("publishing via deleted event", "error", err, "contact_id", removed.ContactID, "via", removed.Via)
+
+			return deleted, errors.Internal("publishing via deleted event", errors.WithCause(err), errors.WithID("service.communication.delete_by_contact"))
+		}
+	}
+
+	return deleted, nil
+}
+
+// SetPrimary makes the via the primary one of its kind and publishes a via updated event for every via whose flag changed.
+func (communicationService *via) SetPrimary(ctx context.Context, command *model.SetPrimaryViaCommand) (*model.ViaCommunication, error) {
+	log := communicationService.logger.With("operation", "set_primary")
+
+	if err := command.Validate(); err != nil {
+		log.Warn("validating set primary command", "error", err)
+
+		return nil, err
+	}
+
+	if err := communicationService.access.contact(ctx, command.ContactID); err != nil {
+		return nil, err
+	}
+
+	stored, err := communicationService.resolveVia(ctx, command.ContactID, "", command.Via)
+	if err != nil {
+		return nil, err
+	}
+
+	command.Via = stored.Via
+
+	command.UpdatedBy = communicationService.access.actor(ctx)
+
+	result, err := communicationService.communicationStore.SetPrimary(ctx, command)
+	if err != nil {
+		log.Error("setting primary via", "error", err, "contact_id", command.ContactID.String(), "via", command.Via)
+
+		return nil, err
+	}
+
+	for _, changed := range result.Changed {
+		if err = communicationService.publisher.Publish(ctx, events.NewViaUpdatedEvent(changed)); err != nil {
+			log.Error("publishing via updated event", "error", err, "contact_id", changed.ContactID, "via", changed.Via)
+
+			return result.Primary, errors.Internal("publishing via updated event", errors.WithCause(err), errors.WithID("service.communication.set_primary"))
+		}
+	}
+
+	return result.Primary, nil
+}
+
+// publishPromoted publishes via updated for the via that became primary in place of the changed one.
+func (communicationService *via) publishPromoted(ctx context.Context, result *model.ViaChangeResult) error {
+	if result.Promoted == nil {
+		return nil
+	}
+
+	if err := communicationService.publisher.Publish(ctx, events.NewViaUpdatedEvent(result.Promoted)); err != nil {
+		communicationService.logger.Error("publishing via updated event", "error", err, "contact_id", result.Promoted.ContactID, "via", result.Promoted.Via)
+
+		return err
+	}
+
+	return nil
+}
+
+// resolveVia finds the via of the contact that value addresses. Every stored form value may have is tried,
+// preferring the one normalized for kind, so vias stored under a kind other than the guessed one are found too.
+func (communicationService *via) resolveVia(ctx context.Context, contactID uuid.UUID, kind model.ViaKind, value string) (*model.ViaCommunication, error) {
+	keys := model.ViaKeys(kind, value)
+	if len(keys) == 0 {
+		return nil, errors.InvalidArgument("via is required", errors.WithID("service.communication.resolve_via"))
+	}
+
+	// the via is about to be changed, a replica may not have it yet
+	records, err := communicationService.communicationStore.Search(pg.WithMaster(ctx), &model.SearchViaCommunicationsFilter{
+		ContactIDs: []uuid.UUID{contactID},
+		Vias:       keys,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		for _, record := range records {
+			if record.Via == key {
+				return record, nil
+			}
+		}
+	}
+
+	return nil, errors.NotFound("via not found", errors.WithID("service.communication.resolve_via"))
+}
+
+// restrictSearch narrows the search to the caller: a contact sees only its own vias, anyone else only vias of its domain.
+func (communicationService *via) restrictSearch(ctx context.Context, filter *model.SearchViaCommunicationsFilter) error {
+	initiator, err := communicationService.access.initiator(ctx)
+	if err != nil {
+		return err
+	}
+
+	if initiator != uuid.Nil {
+		for _, contactID := range filter.ContactIDs {
+			if contactID != initiator {
+				return errors.Forbidden("contact can access only own data", errors.WithID("service.communication.search"))
+			}
+		}
+
+		filter.ContactIDs = []uuid.UUID{initiator}
+	}
+
+	filter.DomainID, err = communicationService.access.domain(ctx, 0)
+
+	return err
+}
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/internal/domain/events"
+	"github.com/webitel/im-contact-service/internal/model"
+)
+
+// ChangeConsent records a marketing consent grant or revocation and publishes it.
+// Consent is kept apart from the operational Disable flag and never changes it.
+func (communicationService *via) ChangeConsent(ctx context.Context, command *model.ChangeViaConsentCommand) (*model.ViaConsent, error) {
+	log := communicationService.logger.With("operation", "change_consent")
+
+	if err := command.Validate(); err != nil {
+		log.Warn("validating change consent command", "error", err)
+
+		return nil, err
+	}
+
+	if err := communicationService.access.contact(ctx, command.ContactID); err != nil {
+		return nil, err
+	}
+
+	stored, err := communicationService.resolveVia(ctx, command.ContactID, "", command.Via)
+	if err != nil {
+		return nil, err
+	}
+
+	command.Via = stored.Via
+
+	command.Normalize()
+
+	recorded, err := communicationService.consentStore.Record(ctx, command)
+	if err != nil {
+		log.Error("recording via consent", "error", err, "contact_id", command.ContactID.String(), "via", command.Via)
+
+		return nil, err
+	}
+
+	if err = communicationService.publisher.Publish(ctx, events.NewViaConsentChangedEvent(recorded)); err != nil {
+		log.Error("publishing via consent event", "error", err, "contact_id", recorded.ContactID, "via", recorded.Via)
+
+		return recorded, errors.Internal("publishing via consent event", errors.WithCause(err), errors.WithID("service.communication.change_consent"))
+	}
+
+	return recorded, nil
+}
+
+// GetConsent returns the current consent of the via and, on request, its full history.
+func (communicationService *via) GetConsent(ctx context.Context, request *model.GetViaConsentRequest) (*model.ViaConsentState, error) {
+	log := communicationService.logger.With("operation", "get_consent")
+
+	if err := request.Validate(); err != nil {
+		log.Warn("validating get consent request", "error", err)
+
+		return nil, err
+	}
+
+	if err := communicationService.access.contact(ctx, request.ContactID); err != nil {
+		return nil, err
+	}
+
+	stored, err := communicationService.resolveVia(ctx, request.ContactID, "", request.Via)
+
+	switch {
+	case err == nil:
+		request.Via = stored.Via
+	case errors.Code(err) == codes.NotFound:
+		// consent history outlives its via, a removed via is read under the form it would be stored in
+		if _, normalized, err := model.NormalizeVia("", request.Via); err == nil {
+			request.Via = normalized
+		}
+	default:
+		return nil, err
+	}
+
+	if !request.IncludeHistory {
+		current, err := communicationService.consentStore.Current(ctx, request.ContactID, request.Via)
+		if err != nil {
+			return nil, err
+		}
+
+		return &model.ViaConsentState{Current: current}, nil
+	}
+
+	history, err := communicationService.consentStore.History(ctx, request.ContactID, request.Via)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &model.ViaConsentState{History: history}
+	if len(history) > 0 {
+		state.Current = history[0]
+	}
+
+	return state, nil
+}
@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/config"
+	"github.com/webitel/im-contact-service/internal/domain/events"
+	"github.com/webitel/im-contact-service/internal/model"
+)
+
+func ProvideDeliveryFailurePolicy(cfg *config.Config) model.DeliveryFailurePolicy {
+	return model.DeliveryFailurePolicy{
+		DisableThreshold: cfg.Delivery.FailureThreshold,
+	}
+}
+
+// RegisterDeliveryFailure counts a failed delivery reported by the message pipeline and
+// publishes via updated once the failure disables the via.
+// Failures that can never be applied, malformed or for a via that no longer exists, are dropped
+// instead of being redelivered. Once the failure is counted a failed publish is only logged,
+// a redelivery would count the same failure twice.
+func (communicationService *via) RegisterDeliveryFailure(ctx context.Context, command *model.RegisterDeliveryFailureCommand) error {
+	log := communicationService.logger.With("operation", "register_delivery_failure")
+
+	if err := command.Validate(); err != nil {
+		log.Warn("dropping invalid delivery failure", "error", err)
+
+		return nil
+	}
+
+	command.Normalize()
+
+	stored, err := communicationService.resolveVia(ctx, command.ContactID, command.Kind, command.Via)
+	if err != nil {
+		if errors.Code(err) == codes.NotFound || errors.Code(err) == codes.InvalidArgument {
+			log.Warn("dropping delivery failure of unknown via", "contact_id", command.ContactID.String(), "via", command.Via)
+
+			return nil
+		}
+
+		return err
+	}
+
+	command.Kind, command.Via = stored.Kind, stored.Via
+
+	result, err := communicationService.communicationStore.RegisterDeliveryFailure(ctx, command, communicationService.failurePolicy)
+	if err != nil {
+		if errors.Code(err) == codes.NotFound {
+			log.Warn("dropping delivery failure of unknown via", "contact_id", command.ContactID.String(), "via", command.Via)
+
+			return nil
+		}
+
+		log.Error("registering delivery failure", "error", err, "contact_id", command.ContactID.String(), "via", command.Via)
+
+		return err
+	}
+
+	if !result.Disabled {
+		return nil
+	}
+
+	log.Info(
+		"via disabled after delivery failures",
+		"contact_id", command.ContactID.String(),
+		"via", command.Via,
+		"failure_count", result.Via.FailureCount,
+		"reason", command.Reason,
+	)
+
+	if err = communicationService.publisher.Publish(ctx, events.NewViaUpdatedEvent(result.Via)); err != nil {
+		log.Error("publishing via updated event", "error", err, "contact_id", command.ContactID.String(), "via", command.Via)
+	}
+
+	return nil
+}
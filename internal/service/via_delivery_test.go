@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/internal/domain/events"
+	"github.com/webitel/im-contact-service/internal/model"
+)
+
+// deliveryViaStore counts failures the way the postgres store does.
+type deliveryViaStore struct {
+	*fakeViaStore
+
+	registered int
+}
+
+func (f *deliveryViaStore) RegisterDeliveryFailure(
+	_ context.Context,
+	command *model.RegisterDeliveryFailureCommand,
+	policy model.DeliveryFailurePolicy,
+) (*model.DeliveryFailureResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i := f.find(command.ContactID, command.Via)
+	if i < 0 {
+		return nil, errors.NotFound("via not found")
+	}
+
+	f.registered++
+
+	v := f.vias[i]
+	v.FailureCount++
+
+	crossed := policy.DisableThreshold > 0 && !v.Disable && v.FailureCount >= policy.DisableThreshold
+	if crossed {
+		reason := model.DisableReasonDeliveryFailures
+		v.Disable, v.DisableReason = true, &reason
+	}
+
+	failed := *v
+
+	return &model.DeliveryFailureResult{Via: &failed, Disabled: crossed}, nil
+}
+
+func newDeliveryFixture(threshold int, publisher *fakePublisher) (*via, *deliveryViaStore, uuid.UUID) {
+	contactID := uuid.New()
+
+	vias := &deliveryViaStore{fakeViaStore: newFakeViaStore(
+		&model.ViaCommunication{ContactID: contactID, Via: verifiedVia, Kind: model.ViaKindEmail},
+	)}
+
+	contacts := &fakeContactStore{contacts: map[uuid.UUID]*model.Contact{
+		contactID: {BaseModel: model.BaseModel{ID: contactID, DomainID: 1}},
+	}}
+
+	service := newCommunication(
+		slog.New(slog.DiscardHandler),
+		vias,
+		nil,
+		nil,
+		contacts,
+		model.VerificationPolicy{},
+		model.DeliveryFailurePolicy{DisableThreshold: threshold},
+		publisher,
+	)
+
+	return service, vias, contactID
+}
+
+func updatedEvents(publisher *fakePublisher) int {
+	publisher.mu.Lock()
+	defer publisher.mu.Unlock()
+
+	var n int
+
+	for _, event := range publisher.events {
+		if _, ok := event.(*events.ViaUpdated); ok {
+			n++
+		}
+	}
+
+	return n
+}
+
+func TestRegisterDeliveryFailureThreshold(t *testing.T) {
+	tests := []struct {
+		name      string
+		threshold int
+		failures  int
+		disabled  bool
+		published int
+	}{
+		{name: "below threshold", threshold: 3, failures: 2},
+		{name: "crossing publishes once", threshold: 3, failures: 5, disabled: true, published: 1},
+		{name: "zero threshold never disables", threshold: 0, failures: 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher := &fakePublisher{}
+			service, vias, contactID := newDeliveryFixture(tt.threshold, publisher)
+
+			for range tt.failures {
+				if err := service.RegisterDeliveryFailure(context.Background(), &model.RegisterDeliveryFailureCommand{ContactID: contactID, Via: verifiedVia}); err != nil {
+					t.Fatalf("registering failure: %v", err)
+				}
+			}
+
+			v := vias.vias[0]
+			if v.FailureCount != tt.failures {
+				t.Fatalf("failure count = %d, want %d", v.FailureCount, tt.failures)
+			}
+
+			if v.Disable != tt.disabled {
+				t.Fatalf("disable = %t, want %t", v.Disable, tt.disabled)
+			}
+
+			if n := updatedEvents(publisher); n != tt.published {
+				t.Fatalf("published %d via updated events, want %d", n, tt.published)
+			}
+		})
+	}
+}
+
+func TestRegisterDeliveryFailureAcksFailedPublish(t *testing.T) {
+	service, vias, contactID := newDeliveryFixture(1, &fakePublisher{err: errors.New("broker is down")})
+
+	if err := service.RegisterDeliveryFailure(context.Background(), &model.RegisterDeliveryFailureCommand{ContactID: contactID, Via: verifiedVia}); err != nil {
+		t.Fatalf("a counted failure must be acked, got %v", err)
+	}
+
+	if vias.registered != 1 || !vias.vias[0].Disable {
+		t.Fatalf("failure was counted %d times, disable = %t", vias.registered, vias.vias[0].Disable)
+	}
+}
+
+func TestRegisterDeliveryFailureDropsUnknownVia(t *testing.T) {
+	service, vias, contactID := newDeliveryFixture(1, &fakePublisher{})
+
+	if err := service.RegisterDeliveryFailure(context.Background(), &model.RegisterDeliveryFailureCommand{ContactID: contactID, Via: "other@example.com"}); err != nil {
+		t.Fatalf("failure of an unknown via must be dropped, got %v", err)
+	}
+
+	if vias.registered != 0 {
+		t.Fatalf("failure of an unknown via was counted")
+	}
+}
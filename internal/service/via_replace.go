@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/internal/domain/events"
+	"github.com/webitel/im-contact-service/internal/model"
+)
+
+// Replace makes the contact vias match the desired set and returns the resulting list.
+// Events are published only for vias that were actually created, updated or deleted.
+func (communicationService *via) Replace(ctx context.Context, command *model.ReplaceViasCommand) ([]*model.ViaCommunication, error) {
+	log := communicationService.logger.With("operation", "replace")
+
+	if err := command.Validate(); err != nil {
+		log.Warn("validating replace command", "error", err)
+
+		return nil, err
+	}
+
+	if err := communicationService.access.contact(ctx, command.ContactID); err != nil {
+		return nil, err
+	}
+
+	command.UpdatedBy = communicationService.access.actor(ctx)
+
+	result, err := communicationService.communicationStore.Replace(ctx, command)
+	if err != nil {
+		if errors.Code(err) == codes.InvalidArgument {
+			log.Warn("planning via replace", "error", err)
+
+			return nil, err
+		}
+
+		log.Error("replacing contact vias", "error", err, "contact_id", command.ContactID.String())
+
+		return nil, err
+	}
+
+	changes := make([]events.Event, 0, len(result.Created)+len(result.Updated)+len(result.Deleted))
+	for _, created := range result.Created {
+		changes = append(changes, events.NewViaCreatedEvent(created))
+	}
+
+	for _, updated := range result.Updated {
+		changes = append(changes, events.NewViaUpdatedEvent(updated))
+	}
+
+	for _, deleted := range result.Deleted {
+		changes = append(changes, events.NewViaDeletedEvent(deleted))
+	}
+
+	for _, event := range changes {
+		if err = communicationService.publisher.Publish(ctx, event); err != nil {
+			log.Error("publishing via replace event", "error", err, "contact_id", command.ContactID.String(), "topic", event.Topic())
+
+			return result.Vias, errors.Internal("publishing via replace event", errors.WithCause(err), errors.WithID("service.communication.replace"))
+		}
+	}
+
+	return result.Vias, nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/internal/domain/events"
+)
+
+// ReenableExpired enables a batch of vias whose suspension ended and publishes via updated for each of them.
+// A failed publish doesn't undo the re-enabling, it is only logged.
+func (communicationService *via) ReenableExpired(ctx context.Context, limit int) (int, error) {
+	log := communicationService.logger.With("operation", "reenable_expired")
+
+	reenabled, err := communicationService.communicationStore.ReenableExpired(ctx, time.Now().UTC(), limit)
+	if err != nil {
+		log.Error("reenabling expired vias", "error", err)
+
+		return 0, errors.Wrap(err, errors.WithID("service.communication.reenable_expired"))
+	}
+
+	for _, via := range reenabled {
+		log.Info("via suspension ended", "contact_id", via.ContactID.String(), "via", via.Via)
+
+		if err := communicationService.publisher.Publish(ctx, events.NewViaUpdatedEvent(via)); err != nil {
+			log.Error("publishing via updated event", "error", err, "contact_id", via.ContactID.String(), "via", via.Via)
+		}
+	}
+
+	return len(reenabled), nil
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+// fakeViaStore keeps vias in memory, addressed by their stored key like the postgres store.
+type fakeViaStore struct {
+	store.ViaStore
+
+	mu   sync.Mutex
+	vias []*model.ViaCommunication
+}
+
+func newFakeViaStore(vias ...*model.ViaCommunication) *fakeViaStore {
+	return &fakeViaStore{vias: vias}
+}
+
+func (f *fakeViaStore) find(contactID uuid.UUID, via string) int {
+	return slices.IndexFunc(f.vias, func(v *model.ViaCommunication) bool {
+		return v.ContactID == contactID && v.Via == via
+	})
+}
+
+func (f *fakeViaStore) Search(_ context.Context, filter *model.SearchViaCommunicationsFilter) ([]*model.ViaCommunication, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var found []*model.ViaCommunication
+
+	for _, v := range f.vias {
+		if len(filter.ContactIDs) > 0 && !slices.Contains(filter.ContactIDs, v.ContactID) {
+			continue
+		}
+
+		if len(filter.Vias) > 0 && !slices.Contains(filter.Vias, v.Via) {
+			continue
+		}
+
+		copied := *v
+		found = append(found, &copied)
+	}
+
+	return found, nil
+}
+
+func (f *fakeViaStore) Delete(_ context.Context, command *model.DeleteViaCommand) (*model.ViaChangeResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i := f.find(command.ContactID, command.Via)
+	if i < 0 {
+		return nil, errors.NotFound("via not found")
+	}
+
+	deleted := f.vias[i]
+	f.vias = slices.Delete(f.vias, i, i+1)
+
+	return &model.ViaChangeResult{Via: deleted}, nil
+}
+
+func (f *fakeViaStore) MarkVerified(_ context.Context, contactID uuid.UUID, via string, _ int) (*model.ViaCommunication, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	i := f.find(contactID, via)
+	if i < 0 {
+		return nil, errors.NotFound("via not found")
+	}
+
+	now := time.Now()
+	f.vias[i].VerifiedAt = &now
+
+	verified := *f.vias[i]
+
+	return &verified, nil
+}
+
+func newViaService(contactID uuid.UUID, vias *fakeViaStore) *via {
+	contacts := &fakeContactStore{contacts: map[uuid.UUID]*model.Contact{
+		contactID: {BaseModel: model.BaseModel{ID: contactID, DomainID: 1}},
+	}}
+
+	return newCommunication(
+		slog.New(slog.DiscardHandler),
+		vias,
+		nil,
+		nil,
+		contacts,
+		model.VerificationPolicy{},
+		model.DeliveryFailurePolicy{},
+		&fakePublisher{},
+	)
+}
+
+func TestDeleteResolvesStoredVia(t *testing.T) {
+	contactID := uuid.New()
+
+	vias := newFakeViaStore(
+		&model.ViaCommunication{ContactID: contactID, Via: "12345678901", Kind: model.ViaKindCustom},
+		&model.ViaCommunication{ContactID: contactID, Via: "+4915112345678", Kind: model.ViaKindWhatsApp},
+	)
+	service := newViaService(contactID, vias)
+
+	tests := []struct {
+		name string
+		via  string
+		want string
+	}{
+		{name: "custom numeric id is not taken for a phone", via: " 12345678901 ", want: "12345678901"},
+		{name: "phone written loosely", via: "+49 151 1234 5678", want: "+4915112345678"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deleted, err := service.Delete(serviceContext(), &model.DeleteViaCommand{ContactID: contactID, Via: tt.via})
+			if err != nil {
+				t.Fatalf("delete: %v", err)
+			}
+
+			if deleted.Via != tt.want {
+				t.Fatalf("deleted %q, want %q", deleted.Via, tt.want)
+			}
+		})
+	}
+
+	if _, err := service.Delete(serviceContext(), &model.DeleteViaCommand{ContactID: contactID, Via: "12345678901"}); errors.Code(err) != codes.NotFound {
+		t.Fatalf("deleting a removed via: err = %v, want NotFound", err)
+	}
+}
+
+func TestResolveViaPrefersGivenKind(t *testing.T) {
+	contactID := uuid.New()
+
+	vias := newFakeViaStore(
+		&model.ViaCommunication{ContactID: contactID, Via: "12345678901", Kind: model.ViaKindCustom},
+		&model.ViaCommunication{ContactID: contactID, Via: "+12345678901", Kind: model.ViaKindPhone},
+	)
+	service := newViaService(contactID, vias)
+
+	for kind, want := range map[model.ViaKind]string{
+		"":                  "12345678901",
+		model.ViaKindCustom: "12345678901",
+		model.ViaKindPhone:  "+12345678901",
+	} {
+		stored, err := service.resolveVia(serviceContext(), contactID, kind, "12345678901")
+		if err != nil {
+			t.Fatalf("resolving with kind %q: %v", kind, err)
+		}
+
+		if stored.Via != want {
+			t.Fatalf("kind %q resolved %q, want %q", kind, stored.Via, want)
+		}
+	}
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/config"
+	"github.com/webitel/im-contact-service/internal/domain/events"
+	"github.com/webitel/im-contact-service/internal/model"
+)
+
+const verificationSaltSize = 16
+
+func ProvideVerificationPolicy(cfg *config.Config) model.VerificationPolicy {
+	return model.VerificationPolicy{
+		CodeTTL:     cfg.Verification.CodeTTL,
+		MaxAttempts: cfg.Verification.MaxAttempts,
+		CodeLength:  cfg.Verification.CodeLength,
+		ResendAfter: cfg.Verification.ResendAfter,
+	}
+}
+
+// StartVerification issues a new one-time code for the via, replacing a pending one created
+// at least the policy ResendAfter ago. The plain code is handed to the delivery service
+// through the event and never stored.
+func (communicationService *via) StartVerification(ctx context.Context, command *model.StartViaVerificationCommand) (*model.ViaVerification, error) {
+	log := communicationService.logger.With("operation", "start_verification")
+
+	if err := command.Validate(); err != nil {
+		log.Warn("validating start verification command", "error", err)
+
+		return nil, err
+	}
+
+	if err := communicationService.access.contact(ctx, command.ContactID); err != nil {
+		return nil, err
+	}
+
+	stored, err := communicationService.resolveVia(ctx, command.ContactID, "", command.Via)
+	if err != nil {
+		return nil, err
+	}
+
+	command.Via = stored.Via
+
+	policy := communicationService.verificationPolicy
+
+	code, err := generateVerificationCode(policy.CodeLength)
+	if err != nil {
+		return nil, errors.Internal("generating verification code", errors.WithCause(err), errors.WithID("service.communication.start_verification"))
+	}
+
+	salt := make([]byte, verificationSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, errors.Internal("generating verification salt", errors.WithCause(err), errors.WithID("service.communication.start_verification"))
+	}
+
+	verification, err := communicationService.verificationStore.Save(ctx, &model.ViaVerification{
+		ContactID:   command.ContactID,
+		Via:         command.Via,
+		CodeHash:    hashVerificationCode(salt, code),
+		Salt:        salt,
+		MaxAttempts: policy.MaxAttempts,
+		ExpiresAt:   time.Now().Add(policy.CodeTTL),
+	}, policy.ResendAfter)
+	if err != nil {
+		log.Error("saving via verification", "error", err, "contact_id", command.ContactID.String(), "via", command.Via)
+
+		return nil, err
+	}
+
+	if verification == nil {
+		return nil, communicationService.resendTooEarly(ctx, command.ContactID, command.Via)
+	}
+
+	if err = communicationService.publisher.Publish(ctx, events.NewViaVerificationRequestedEvent(verification, code)); err != nil {
+		log.Error("publishing via verification requested event", "error", err, "contact_id", command.ContactID.String(), "via", command.Via)
+
+		return nil, errors.Internal("publishing via verification requested event", errors.WithCause(err), errors.WithID("service.communication.start_verification"))
+	}
+
+	return verification, nil
+}
+
+// ConfirmVerification checks the code against the pending verification and marks the via verified.
+// Every confirmation spends an attempt; an expired or exhausted verification has to be started again.
+func (communicationService *via) ConfirmVerification(ctx context.Context, command *model.ConfirmViaVerificationCommand) (*model.ViaCommunication, error) {
+	log := communicationService.logger.With("operation", "confirm_verification")
+
+	if err := command.Validate(); err != nil {
+		log.Warn("validating confirm verification command", "error", err)
+
+		return nil, err
+	}
+
+	if err := communicationService.access.contact(ctx, command.ContactID); err != nil {
+		return nil, err
+	}
+
+	stored, err := communicationService.resolveVia(ctx, command.ContactID, "", command.Via)
+	if err != nil {
+		return nil, err
+	}
+
+	command.Via = stored.Via
+
+	// the attempt is spent before comparing, so concurrent guesses cannot get past the limit
+	verification, err := communicationService.verificationStore.ReserveAttempt(ctx, command.ContactID, command.Via)
+	if err != nil {
+		log.Error("reserving verification attempt", "error", err, "contact_id", command.ContactID.String(), "via", command.Via)
+
+		return nil, err
+	}
+
+	if verification == nil {
+		return nil, communicationService.noAttemptLeft(ctx, command.ContactID, command.Via)
+	}
+
+	if subtle.ConstantTimeCompare(hashVerificationCode(verification.Salt, strings.TrimSpace(command.Code)), verification.CodeHash) != 1 {
+		return nil, errors.InvalidArgument(
+			"verification code mismatch",
+			errors.WithID("service.communication.confirm_verification"),
+			errors.WithValue("attempts_left", verification.AttemptsLeft()),
+		)
+	}
+
+	verified, err := communicationService.communicationStore.MarkVerified(ctx, command.ContactID, command.Via, communicationService.access.actor(ctx))
+	if err != nil {
+		log.Error("marking via verified", "error", err, "contact_id", command.ContactID.String(), "via", command.Via)
+
+		return nil, err
+	}
+
+	// the via is already verified at this point, a leftover code only lingers until it expires
+	if err := communicationService.verificationStore.Delete(ctx, command.ContactID, command.Via); err != nil {
+		log.Warn("deleting confirmed verification", "error", err, "contact_id", command.ContactID.String(), "via", command.Via)
+	}
+
+	if err = communicationService.publisher.Publish(ctx, events.NewViaVerifiedEvent(verified)); err != nil {
+		log.Error("publishing via verified event", "error", err, "contact_id", verified.ContactID, "via", verified.Via)
+
+		return verified, errors.Internal("publishing via verified event", errors.WithCause(err), errors.WithID("service.communication.confirm_verification"))
+	}
+
+	return verified, nil
+}
+
+// resendTooEarly explains why a new code was not issued for the via.
+func (communicationService *via) resendTooEarly(ctx context.Context, contactID uuid.UUID, via string) error {
+	pending, err := communicationService.verificationStore.Get(ctx, contactID, via)
+	if err != nil {
+		return err
+	}
+
+	var retryAfter time.Duration
+	if pending != nil {
+		retryAfter = max(time.Until(pending.CreatedAt.Add(communicationService.verificationPolicy.ResendAfter)), 0)
+	}
+
+	return errors.New(
+		"verification code was sent recently",
+		errors.WithCode(codes.ResourceExhausted),
+		errors.WithID("service.communication.start_verification"),
+		errors.WithValue("retry_after_ms", retryAfter.Milliseconds()),
+	)
+}
+
+// noAttemptLeft explains why no attempt could be reserved for the via.
+func (communicationService *via) noAttemptLeft(ctx context.Context, contactID uuid.UUID, via string) error {
+	pending, err := communicationService.verificationStore.Get(ctx, contactID, via)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case pending == nil:
+		return errors.NotFound("no pending verification for via", errors.WithID("service.communication.confirm_verification"))
+	case pending.Expired(time.Now()):
+		return errors.New("verification code expired", errors.WithCode(codes.FailedPrecondition), errors.WithID("service.communication.confirm_verification"))
+	default:
+		return errors.New("verification attempts exhausted", errors.WithCode(codes.FailedPrecondition), errors.WithID("service.communication.confirm_verification"))
+	}
+}
+
+// generateVerificationCode returns a uniformly random numeric code of the given length.
+func generateVerificationCode(length int) (string, error) {
+	var code strings.Builder
+
+	code.Grow(length)
+
+	for range length {
+		digit, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+
+		code.WriteByte(byte('0' + digit.Int64()))
+	}
+
+	return code.String(), nil
+}
+
+func hashVerificationCode(salt []byte, code string) []byte {
+	sum := sha256.Sum256(append(append([]byte{}, salt...), code...))
+
+	return sum[:]
+}
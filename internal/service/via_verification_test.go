@@ -0,0 +1,288 @@
+package service
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/internal/domain/events"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+// fakeVerificationStore keeps the guarantees of the postgres store: every method is atomic.
+type fakeVerificationStore struct {
+	store.ViaVerificationStore
+
+	mu            sync.Mutex
+	verifications map[string]*model.ViaVerification
+}
+
+func verificationKey(contactID uuid.UUID, via string) string {
+	return contactID.String() + "/" + via
+}
+
+func (f *fakeVerificationStore) Save(_ context.Context, verification *model.ViaVerification, resendAfter time.Duration) (*model.ViaVerification, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	key := verificationKey(verification.ContactID, verification.Via)
+	if pending, ok := f.verifications[key]; ok && time.Since(pending.CreatedAt) < resendAfter {
+		return nil, nil
+	}
+
+	saved := *verification
+	saved.CreatedAt = time.Now()
+	f.verifications[key] = &saved
+
+	return &saved, nil
+}
+
+func (f *fakeVerificationStore) Get(_ context.Context, contactID uuid.UUID, via string) (*model.ViaVerification, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if pending, ok := f.verifications[verificationKey(contactID, via)]; ok {
+		found := *pending
+
+		return &found, nil
+	}
+
+	return nil, nil
+}
+
+func (f *fakeVerificationStore) ReserveAttempt(_ context.Context, contactID uuid.UUID, via string) (*model.ViaVerification, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	pending, ok := f.verifications[verificationKey(contactID, via)]
+	if !ok || pending.Exhausted() || pending.Expired(time.Now()) {
+		return nil, nil
+	}
+
+	pending.Attempts++
+	reserved := *pending
+
+	return &reserved, nil
+}
+
+func (f *fakeVerificationStore) Delete(_ context.Context, contactID uuid.UUID, via string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	delete(f.verifications, verificationKey(contactID, via))
+
+	return nil
+}
+
+type fakePublisher struct {
+	mu     sync.Mutex
+	events []events.Event
+	// err, when set, fails every publish.
+	err error
+}
+
+func (f *fakePublisher) Publish(_ context.Context, event events.Event) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.err != nil {
+		return f.err
+	}
+
+	f.events = append(f.events, event)
+
+	return nil
+}
+
+// lastCode returns the code of the last verification requested event.
+func (f *fakePublisher) lastCode(t *testing.T) string {
+	t.Helper()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for i := len(f.events) - 1; i >= 0; i-- {
+		if requested, ok := f.events[i].(*events.ViaVerificationRequested); ok {
+			return requested.Code
+		}
+	}
+
+	t.Fatal("no verification code was published")
+
+	return ""
+}
+
+type verificationFixture struct {
+	service       *via
+	verifications *fakeVerificationStore
+	publisher     *fakePublisher
+	contactID     uuid.UUID
+}
+
+const verifiedVia = "user@example.com"
+
+func newVerificationFixture(maxAttempts int) *verificationFixture {
+	contactID := uuid.New()
+
+	f := &verificationFixture{
+		verifications: &fakeVerificationStore{verifications: make(map[string]*model.ViaVerification)},
+		publisher:     &fakePublisher{},
+		contactID:     contactID,
+	}
+
+	contacts := &fakeContactStore{contacts: map[uuid.UUID]*model.Contact{
+		contactID: {BaseModel: model.BaseModel{ID: contactID, DomainID: 1}},
+	}}
+
+	vias := newFakeViaStore(&model.ViaCommunication{ContactID: contactID, Via: verifiedVia, Kind: model.ViaKindEmail})
+
+	f.service = newCommunication(
+		slog.New(slog.DiscardHandler),
+		vias,
+		f.verifications,
+		nil,
+		contacts,
+		model.VerificationPolicy{MaxAttempts: maxAttempts, ResendAfter: time.Minute},
+		model.DeliveryFailurePolicy{},
+		f.publisher,
+	)
+
+	return f
+}
+
+func (f *verificationFixture) start(t *testing.T) string {
+	t.Helper()
+
+	if _, err := f.service.StartVerification(serviceContext(), &model.StartViaVerificationCommand{ContactID: f.contactID, Via: verifiedVia}); err != nil {
+		t.Fatalf("starting verification: %v", err)
+	}
+
+	return f.publisher.lastCode(t)
+}
+
+func (f *verificationFixture) confirm(code string) (*model.ViaCommunication, error) {
+	return f.service.ConfirmVerification(serviceContext(), &model.ConfirmViaVerificationCommand{ContactID: f.contactID, Via: verifiedVia, Code: code})
+}
+
+// wrongCode returns a code of the same length that differs from code.
+func wrongCode(code string) string {
+	if code[0] == '0' {
+		return "1" + code[1:]
+	}
+
+	return "0" + code[1:]
+}
+
+func TestConfirmVerification(t *testing.T) {
+	f := newVerificationFixture(3)
+	code := f.start(t)
+
+	if _, err := f.confirm(wrongCode(code)); errors.Code(err) != codes.InvalidArgument {
+		t.Fatalf("wrong code: err = %v, want InvalidArgument", err)
+	}
+
+	verified, err := f.confirm(" " + code + " ")
+	if err != nil {
+		t.Fatalf("confirming: %v", err)
+	}
+
+	if verified.VerifiedAt == nil {
+		t.Fatal("expected the via to be verified")
+	}
+
+	if _, err := f.confirm(code); errors.Code(err) != codes.NotFound {
+		t.Fatalf("confirmed code reused: err = %v, want NotFound", err)
+	}
+}
+
+func TestConfirmVerificationConcurrentGuesses(t *testing.T) {
+	const maxAttempts = 3
+
+	f := newVerificationFixture(maxAttempts)
+	code := f.start(t)
+
+	var (
+		wg         sync.WaitGroup
+		mu         sync.Mutex
+		mismatches int
+	)
+
+	for range 20 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := f.confirm(wrongCode(code))
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			switch errors.Code(err) {
+			case codes.InvalidArgument:
+				mismatches++
+			case codes.FailedPrecondition:
+			default:
+				t.Errorf("guess: err = %v, want InvalidArgument or FailedPrecondition", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if mismatches != maxAttempts {
+		t.Fatalf("%d guesses were compared, want %d", mismatches, maxAttempts)
+	}
+
+	if _, err := f.confirm(code); errors.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("right code after exhaustion: err = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestConfirmVerificationExpired(t *testing.T) {
+	f := newVerificationFixture(3)
+	code := f.start(t)
+
+	f.verifications.verifications[verificationKey(f.contactID, verifiedVia)].ExpiresAt = time.Now().Add(-time.Second)
+
+	if _, err := f.confirm(code); errors.Code(err) != codes.FailedPrecondition {
+		t.Fatalf("expired code: err = %v, want FailedPrecondition", err)
+	}
+}
+
+func TestStartVerificationResendAfter(t *testing.T) {
+	f := newVerificationFixture(3)
+	code := f.start(t)
+
+	if _, err := f.confirm(wrongCode(code)); errors.Code(err) != codes.InvalidArgument {
+		t.Fatalf("wrong code: err = %v, want InvalidArgument", err)
+	}
+
+	_, err := f.service.StartVerification(serviceContext(), &model.StartViaVerificationCommand{ContactID: f.contactID, Via: verifiedVia})
+	if errors.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("early resend: err = %v, want ResourceExhausted", err)
+	}
+
+	pending := f.verifications.verifications[verificationKey(f.contactID, verifiedVia)]
+	if pending.Attempts != 1 {
+		t.Fatalf("early resend reset attempts to %d", pending.Attempts)
+	}
+
+	pending.CreatedAt = time.Now().Add(-time.Minute)
+
+	if resent := f.start(t); resent == "" {
+		t.Fatal("expected a new code once the resend delay passed")
+	}
+
+	if pending = f.verifications.verifications[verificationKey(f.contactID, verifiedVia)]; pending.Attempts != 0 {
+		t.Fatalf("new code kept %d spent attempts", pending.Attempts)
+	}
+}
@@ -0,0 +1,138 @@
+package cached
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/fx"
+
+	"github.com/webitel/im-contact-service/config"
+	"github.com/webitel/im-contact-service/infra/cache"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+const (
+	keyPrefix = "im_contact:"
+
+	defaultContactTTL  = 5 * time.Minute
+	defaultSettingsTTL = 5 * time.Minute
+)
+
+// TTL defines expiration of every cached entity kind.
+type TTL struct {
+	Contact  time.Duration
+	Settings time.Duration
+}
+
+// Decorators wraps the database stores with the cache layer.
+// It is an option set rather than a module, so the decorated stores are visible to the whole app.
+var Decorators = fx.Options(
+	fx.Provide(ProvideTTL),
+	fx.Decorate(
+		func(inner store.ContactStore, c cache.Cache, ttl TTL, l *slog.Logger) store.ContactStore {
+			return NewContactStore(inner, c, ttl, l)
+		},
+		func(inner store.SettingsStore, c cache.Cache, ttl TTL, l *slog.Logger) store.SettingsStore {
+			return NewSettingsStore(inner, c, ttl, l)
+		},
+		func(inner store.ViaStore, c cache.Cache, ttl TTL, l *slog.Logger) store.ViaStore {
+			return NewViaStore(inner, c, ttl, l)
+		},
+		func(inner store.SettingsTemplateStore, c cache.Cache, ttl TTL, l *slog.Logger) store.SettingsTemplateStore {
+			return NewSettingsTemplateStore(inner, c, ttl, l)
+		},
+	),
+)
+
+func ProvideTTL(cfg *config.Config) TTL {
+	return TTL{
+		Contact:  cfg.Cache.ContactTTL,
+		Settings: cfg.Cache.SettingsTTL,
+	}
+}
+
+func (t TTL) contact() time.Duration {
+	if t.Contact <= 0 {
+		return defaultContactTTL
+	}
+
+	return t.Contact
+}
+
+func (t TTL) settings() time.Duration {
+	if t.Settings <= 0 {
+		return defaultSettingsTTL
+	}
+
+	return t.Settings
+}
+
+func contactKey(id uuid.UUID) string  { return keyPrefix + "contact:" + id.String() }
+func locateKey(id uuid.UUID) string   { return keyPrefix + "contact_locate:" + id.String() }
+func settingsKey(id uuid.UUID) string { return keyPrefix + "settings:" + id.String() }
+
+// contactKeys returns every key that holds data derived from the contact row.
+func contactKeys(ids ...uuid.UUID) []string {
+	keys := make([]string, 0, len(ids)*3)
+	for _, id := range ids {
+		keys = append(keys, contactKey(id), locateKey(id), settingsKey(id))
+	}
+
+	return keys
+}
+
+// base holds the cache helpers shared by every decorated store.
+// Cache failures never fail a request: reads fall through to the database
+// and failed invalidations are logged, the entry then lives until its TTL.
+type base struct {
+	cache  cache.Cache
+	ttl    TTL
+	logger *slog.Logger
+}
+
+func (b *base) load(ctx context.Context, key string, out any) bool {
+	raw, ok, err := b.cache.Get(ctx, key)
+	if err != nil {
+		b.logger.Warn("reading cache", "error", err, "key", key)
+
+		return false
+	}
+
+	if !ok {
+		return false
+	}
+
+	if err := json.Unmarshal(raw, out); err != nil {
+		b.logger.Warn("decoding cached value", "error", err, "key", key)
+
+		return false
+	}
+
+	return true
+}
+
+func (b *base) save(ctx context.Context, key string, value any, ttl time.Duration) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		b.logger.Warn("encoding value for cache", "error", err, "key", key)
+
+		return
+	}
+
+	if err := b.cache.Set(ctx, key, raw, ttl); err != nil {
+		b.logger.Warn("writing cache", "error", err, "key", key)
+	}
+}
+
+func (b *base) invalidate(ctx context.Context, keys ...string) {
+	if len(keys) == 0 {
+		return
+	}
+
+	if err := b.cache.Delete(ctx, keys...); err != nil {
+		b.logger.Error("invalidating cache", "error", err, "keys", keys)
+	}
+}
@@ -0,0 +1,200 @@
+package cached
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/infra/cache"
+	"github.com/webitel/im-contact-service/infra/db/pg"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+	"github.com/webitel/im-contact-service/internal/store/queries"
+)
+
+var _ store.ContactStore = (*contactStore)(nil)
+
+type contactStore struct {
+	base
+
+	store store.ContactStore
+}
+
+func NewContactStore(inner store.ContactStore, c cache.Cache, ttl TTL, logger *slog.Logger) store.ContactStore {
+	return &contactStore{
+		base:  base{cache: c, ttl: ttl, logger: logger.With("component", "cached_contact_store")},
+		store: inner,
+	}
+}
+
+// Locate implements [store.ContactStore].
+func (s *contactStore) Locate(ctx context.Context, locate *model.LocateContactRequest) (*model.Contact, error) {
+	key := locateKey(locate.ID)
+
+	var contact model.Contact
+	if s.load(ctx, key, &contact) {
+		if contact.DomainID != locate.DC {
+			return nil, errors.NotFound("contact doesn`t exist", errors.WithID("cached.contact_store.locate"))
+		}
+
+		return &contact, nil
+	}
+
+	// fill the cache from master, so that replica lag never gets cached for the whole TTL
+	located, err := s.store.Locate(pg.WithMaster(ctx), locate)
+	if err != nil {
+		return nil, err
+	}
+
+	s.save(ctx, key, located, s.ttl.contact())
+
+	return located, nil
+}
+
+// ResolveByVia implements [store.ContactStore]. Lookups by via are not cached.
+func (s *contactStore) ResolveByVia(ctx context.Context, request *model.ResolveByViaRequest) ([]*model.ResolvedContact, error) {
+	return s.store.ResolveByVia(ctx, request)
+}
+
+// FindByIDs implements [store.ContactStore].
+func (s *contactStore) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Contact, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = contactKey(id)
+	}
+
+	cached, err := s.cache.MGet(ctx, keys...)
+	if err != nil {
+		s.logger.Warn("reading cache", "error", err)
+
+		cached = make([][]byte, len(ids))
+	}
+
+	var (
+		found   = make([]*model.Contact, 0, len(ids))
+		missing = make([]uuid.UUID, 0, len(ids))
+	)
+
+	for i, raw := range cached {
+		if raw == nil {
+			missing = append(missing, ids[i])
+
+			continue
+		}
+
+		var contact model.Contact
+		if err := json.Unmarshal(raw, &contact); err != nil {
+			missing = append(missing, ids[i])
+
+			continue
+		}
+
+		found = append(found, &contact)
+	}
+
+	if len(missing) == 0 {
+		return found, nil
+	}
+
+	loaded, err := s.store.FindByIDs(pg.WithMaster(ctx), missing)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, contact := range loaded {
+		s.save(ctx, contactKey(contact.ID), contact, s.ttl.contact())
+	}
+
+	return append(found, loaded...), nil
+}
+
+// Search implements [store.ContactStore].
+func (s *contactStore) Search(ctx context.Context, filter *model.ContactSearchRequest) ([]*model.Contact, error) {
+	return s.store.Search(ctx, filter)
+}
+
+// Create implements [store.ContactStore].
+func (s *contactStore) Create(ctx context.Context, contact *model.Contact) (*model.Contact, error) {
+	return s.store.Create(ctx, contact)
+}
+
+// Update implements [store.ContactStore].
+func (s *contactStore) Update(ctx context.Context, updater *model.UpdateContactRequest) (*model.Contact, error) {
+	updated, err := s.store.Update(ctx, updater)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, contactKey(updater.ID), locateKey(updater.ID))
+
+	return updated, nil
+}
+
+// Upsert implements [store.ContactStore].
+func (s *contactStore) Upsert(ctx context.Context, contact *model.Contact) (*model.Contact, bool, error) {
+	upserted, isInsert, err := s.store.Upsert(ctx, contact)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !isInsert {
+		s.invalidate(ctx, contactKey(upserted.ID), locateKey(upserted.ID))
+	}
+
+	return upserted, isInsert, nil
+}
+
+// PartialUpdate implements [store.ContactStore].
+func (s *contactStore) PartialUpdate(ctx context.Context, query queries.Query) (*model.Contact, error) {
+	updated, err := s.store.PartialUpdate(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, contactKey(updated.ID), locateKey(updated.ID))
+
+	return updated, nil
+}
+
+// Delete implements [store.ContactStore].
+func (s *contactStore) Delete(ctx context.Context, command *model.DeleteContactRequest) error {
+	if err := s.store.Delete(ctx, command); err != nil {
+		return err
+	}
+
+	s.invalidate(ctx, contactKeys(command.ID)...)
+
+	return nil
+}
+
+// ClearByDomain implements [store.ContactStore].
+func (s *contactStore) ClearByDomain(ctx context.Context, domainID int) ([]uuid.UUID, error) {
+	ids, err := s.store.ClearByDomain(ctx, domainID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, contactKeys(ids...)...)
+
+	return ids, nil
+}
+
+// DeleteBotByFlowID implements [store.ContactStore].
+func (s *contactStore) DeleteBotByFlowID(ctx context.Context, flowID string) ([]uuid.UUID, error) {
+	ids, err := s.store.DeleteBotByFlowID(ctx, flowID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, contactKeys(ids...)...)
+
+	return ids, nil
+}
@@ -0,0 +1,123 @@
+package cached
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/im-contact-service/infra/cache/memory"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+type fakeContactStore struct {
+	store.ContactStore
+
+	contacts map[uuid.UUID]*model.Contact
+	reads    int
+}
+
+func (f *fakeContactStore) Locate(_ context.Context, locate *model.LocateContactRequest) (*model.Contact, error) {
+	f.reads++
+
+	return f.contacts[locate.ID], nil
+}
+
+func (f *fakeContactStore) FindByIDs(_ context.Context, ids []uuid.UUID) ([]*model.Contact, error) {
+	f.reads++
+
+	found := make([]*model.Contact, 0, len(ids))
+	for _, id := range ids {
+		if c, ok := f.contacts[id]; ok {
+			found = append(found, c)
+		}
+	}
+
+	return found, nil
+}
+
+func (f *fakeContactStore) Update(_ context.Context, updater *model.UpdateContactRequest) (*model.Contact, error) {
+	c := f.contacts[updater.ID]
+	c.Name = *updater.Name
+
+	return c, nil
+}
+
+func newTestContactStore() (*fakeContactStore, store.ContactStore) {
+	inner := &fakeContactStore{contacts: make(map[uuid.UUID]*model.Contact)}
+
+	return inner, NewContactStore(inner, memory.New(), TTL{}, slog.New(slog.DiscardHandler))
+}
+
+func TestContactStoreLocate(t *testing.T) {
+	ctx := context.Background()
+	inner, cachedStore := newTestContactStore()
+
+	id := uuid.New()
+	inner.contacts[id] = &model.Contact{BaseModel: model.BaseModel{ID: id, DomainID: 1}, Name: "before"}
+
+	for range 2 {
+		contact, err := cachedStore.Locate(ctx, &model.LocateContactRequest{ID: id, DC: 1})
+		if err != nil {
+			t.Fatalf("locate: %v", err)
+		}
+
+		if contact.Name != "before" {
+			t.Fatalf("unexpected name %q", contact.Name)
+		}
+	}
+
+	if inner.reads != 1 {
+		t.Fatalf("expected a single store read, got %d", inner.reads)
+	}
+
+	if _, err := cachedStore.Locate(ctx, &model.LocateContactRequest{ID: id, DC: 2}); err == nil {
+		t.Fatal("expected cached contact to be hidden from another domain")
+	}
+
+	name := "after"
+	if _, err := cachedStore.Update(ctx, &model.UpdateContactRequest{ID: id, DomainID: 1, Name: &name}); err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	contact, err := cachedStore.Locate(ctx, &model.LocateContactRequest{ID: id, DC: 1})
+	if err != nil {
+		t.Fatalf("locate after update: %v", err)
+	}
+
+	if contact.Name != "after" || inner.reads != 2 {
+		t.Fatalf("expected update to invalidate the cache, name %q, reads %d", contact.Name, inner.reads)
+	}
+}
+
+func TestContactStoreFindByIDs(t *testing.T) {
+	ctx := context.Background()
+	inner, cachedStore := newTestContactStore()
+
+	first, second := uuid.New(), uuid.New()
+	inner.contacts[first] = &model.Contact{BaseModel: model.BaseModel{ID: first}}
+	inner.contacts[second] = &model.Contact{BaseModel: model.BaseModel{ID: second}}
+
+	if _, err := cachedStore.FindByIDs(ctx, []uuid.UUID{first}); err != nil {
+		t.Fatalf("find first: %v", err)
+	}
+
+	contacts, err := cachedStore.FindByIDs(ctx, []uuid.UUID{first, second})
+	if err != nil {
+		t.Fatalf("find pair: %v", err)
+	}
+
+	if len(contacts) != 2 {
+		t.Fatalf("expected 2 contacts, got %d", len(contacts))
+	}
+
+	if _, err := cachedStore.FindByIDs(ctx, []uuid.UUID{first, second}); err != nil {
+		t.Fatalf("find cached pair: %v", err)
+	}
+
+	if inner.reads != 2 {
+		t.Fatalf("expected only missing contacts to be read from store, got %d reads", inner.reads)
+	}
+}
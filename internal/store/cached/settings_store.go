@@ -0,0 +1,128 @@
+package cached
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/im-contact-service/infra/cache"
+	"github.com/webitel/im-contact-service/infra/db/pg"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+var _ store.SettingsStore = (*settingsStore)(nil)
+
+type settingsStore struct {
+	base
+
+	store store.SettingsStore
+}
+
+func NewSettingsStore(inner store.SettingsStore, c cache.Cache, ttl TTL, logger *slog.Logger) store.SettingsStore {
+	return &settingsStore{
+		base:  base{cache: c, ttl: ttl, logger: logger.With("component", "cached_settings_store")},
+		store: inner,
+	}
+}
+
+// Get implements [store.SettingsStore].
+func (s *settingsStore) Get(ctx context.Context, contactID uuid.UUID) (*model.ContactSettings, error) {
+	key := settingsKey(contactID)
+
+	var settings model.ContactSettings
+	if s.load(ctx, key, &settings) {
+		return &settings, nil
+	}
+
+	loaded, err := s.store.Get(pg.WithMaster(ctx), contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.save(ctx, key, loaded, s.ttl.settings())
+
+	return loaded, nil
+}
+
+// GetMany implements [store.SettingsStore].
+func (s *settingsStore) GetMany(ctx context.Context, contactIDs []uuid.UUID) ([]*model.ContactSettings, error) {
+	if len(contactIDs) == 0 {
+		return nil, nil
+	}
+
+	keys := make([]string, len(contactIDs))
+	for i, id := range contactIDs {
+		keys[i] = settingsKey(id)
+	}
+
+	cached, err := s.cache.MGet(ctx, keys...)
+	if err != nil {
+		s.logger.Warn("reading cache", "error", err)
+
+		cached = make([][]byte, len(contactIDs))
+	}
+
+	var (
+		found   = make([]*model.ContactSettings, 0, len(contactIDs))
+		missing = make([]uuid.UUID, 0, len(contactIDs))
+	)
+
+	for i, raw := range cached {
+		if raw == nil {
+			missing = append(missing, contactIDs[i])
+
+			continue
+		}
+
+		var settings model.ContactSettings
+		if err := json.Unmarshal(raw, &settings); err != nil {
+			missing = append(missing, contactIDs[i])
+
+			continue
+		}
+
+		found = append(found, &settings)
+	}
+
+	if len(missing) == 0 {
+		return found, nil
+	}
+
+	loaded, err := s.store.GetMany(pg.WithMaster(ctx), missing)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, settings := range loaded {
+		s.save(ctx, settingsKey(settings.ContactID), settings, s.ttl.settings())
+	}
+
+	return append(found, loaded...), nil
+}
+
+// Update implements [store.SettingsStore].
+func (s *settingsStore) Update(ctx context.Context, command *model.UpdateContactSettingsRequest) (*model.ContactSettings, *model.ContactSettings, error) {
+	old, updated, err := s.store.Update(ctx, command)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	s.invalidate(ctx, settingsKey(command.ContactID))
+
+	return old, updated, nil
+}
+
+// Create implements [store.SettingsStore].
+func (s *settingsStore) Create(ctx context.Context, command *model.CreateContactSettingsRequest) (*model.ContactSettings, error) {
+	created, err := s.store.Create(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, settingsKey(command.ContactID))
+
+	return created, nil
+}
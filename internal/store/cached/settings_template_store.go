@@ -0,0 +1,48 @@
+package cached
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+
+	"github.com/webitel/im-contact-service/infra/cache"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+// invalidateBatch bounds the keys of a single cache delete when a template changes many settings at once.
+const invalidateBatch = 500
+
+var _ store.SettingsTemplateStore = (*settingsTemplateStore)(nil)
+
+// settingsTemplateStore caches nothing itself; it drops the cached settings a template lock has changed.
+type settingsTemplateStore struct {
+	store.SettingsTemplateStore
+	base
+}
+
+func NewSettingsTemplateStore(inner store.SettingsTemplateStore, c cache.Cache, ttl TTL, logger *slog.Logger) store.SettingsTemplateStore {
+	return &settingsTemplateStore{
+		SettingsTemplateStore: inner,
+		base:                  base{cache: c, ttl: ttl, logger: logger.With("component", "cached_settings_template_store")},
+	}
+}
+
+// Upsert implements [store.SettingsTemplateStore].
+func (s *settingsTemplateStore) Upsert(ctx context.Context, template *model.SettingsTemplate) (*model.SettingsTemplate, []*model.SettingsChange, error) {
+	saved, enforced, err := s.SettingsTemplateStore.Upsert(ctx, template)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for batch := range slices.Chunk(enforced, invalidateBatch) {
+		keys := make([]string, len(batch))
+		for i, change := range batch {
+			keys[i] = settingsKey(change.Updated.ContactID)
+		}
+
+		s.invalidate(ctx, keys...)
+	}
+
+	return saved, enforced, nil
+}
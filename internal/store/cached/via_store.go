@@ -0,0 +1,160 @@
+package cached
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/im-contact-service/infra/cache"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+var _ store.ViaStore = (*viaStore)(nil)
+
+// viaStore does not cache vias itself, it only drops located contacts that embed them.
+type viaStore struct {
+	base
+
+	store store.ViaStore
+}
+
+func NewViaStore(inner store.ViaStore, c cache.Cache, ttl TTL, logger *slog.Logger) store.ViaStore {
+	return &viaStore{
+		base:  base{cache: c, ttl: ttl, logger: logger.With("component", "cached_via_store")},
+		store: inner,
+	}
+}
+
+// Create implements [store.ViaStore].
+func (s *viaStore) Create(ctx context.Context, communication *model.CreateViaCommunicationCommand) (*model.ViaCommunication, error) {
+	created, err := s.store.Create(ctx, communication)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, locateKey(created.ContactID))
+
+	return created, nil
+}
+
+// Update implements [store.ViaStore].
+func (s *viaStore) Update(ctx context.Context, communication *model.ViaCommunication) (*model.ViaChangeResult, error) {
+	result, err := s.store.Update(ctx, communication)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, locateKey(result.Via.ContactID))
+
+	return result, nil
+}
+
+// PartialUpdate implements [store.ViaStore].
+func (s *viaStore) PartialUpdate(ctx context.Context, updateCommand *model.CommunicationViaPartialUpdateCmd) (*model.ViaChangeResult, error) {
+	result, err := s.store.PartialUpdate(ctx, updateCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, locateKey(result.Via.ContactID))
+
+	return result, nil
+}
+
+// Search implements [store.ViaStore].
+func (s *viaStore) Search(ctx context.Context, filter *model.SearchViaCommunicationsFilter) ([]*model.ViaCommunication, error) {
+	return s.store.Search(ctx, filter)
+}
+
+// Delete implements [store.ViaStore].
+func (s *viaStore) Delete(ctx context.Context, deleteCommand *model.DeleteViaCommand) (*model.ViaChangeResult, error) {
+	result, err := s.store.Delete(ctx, deleteCommand)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, locateKey(result.Via.ContactID))
+
+	return result, nil
+}
+
+// DeleteByContact implements [store.ViaStore].
+func (s *viaStore) DeleteByContact(ctx context.Context, contactID uuid.UUID) ([]*model.ViaCommunication, error) {
+	deleted, err := s.store.DeleteByContact(ctx, contactID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, locateKey(contactID))
+
+	return deleted, nil
+}
+
+// MarkVerified implements [store.ViaStore].
+func (s *viaStore) MarkVerified(ctx context.Context, contactID uuid.UUID, via string, updatedBy int) (*model.ViaCommunication, error) {
+	verified, err := s.store.MarkVerified(ctx, contactID, via, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, locateKey(contactID))
+
+	return verified, nil
+}
+
+// SetPrimary implements [store.ViaStore].
+func (s *viaStore) SetPrimary(ctx context.Context, command *model.SetPrimaryViaCommand) (*model.SetPrimaryViaResult, error) {
+	result, err := s.store.SetPrimary(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, locateKey(command.ContactID))
+
+	return result, nil
+}
+
+// RegisterDeliveryFailure implements [store.ViaStore].
+func (s *viaStore) RegisterDeliveryFailure(
+	ctx context.Context,
+	command *model.RegisterDeliveryFailureCommand,
+	policy model.DeliveryFailurePolicy,
+) (*model.DeliveryFailureResult, error) {
+	result, err := s.store.RegisterDeliveryFailure(ctx, command, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, locateKey(command.ContactID))
+
+	return result, nil
+}
+
+// ReenableExpired implements [store.ViaStore].
+func (s *viaStore) ReenableExpired(ctx context.Context, now time.Time, limit int) ([]*model.ViaCommunication, error) {
+	reenabled, err := s.store.ReenableExpired(ctx, now, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, via := range reenabled {
+		s.invalidate(ctx, locateKey(via.ContactID))
+	}
+
+	return reenabled, nil
+}
+
+// Replace implements [store.ViaStore].
+func (s *viaStore) Replace(ctx context.Context, command *model.ReplaceViasCommand) (*model.ReplaceViasResult, error) {
+	result, err := s.store.Replace(ctx, command)
+	if err != nil {
+		return nil, err
+	}
+
+	s.invalidate(ctx, locateKey(command.ContactID))
+
+	return result, nil
+}
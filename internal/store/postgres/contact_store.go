@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgconn"
 	"google.golang.org/grpc/codes"
@@ -35,14 +36,16 @@ func (c *contactStore) Create(ctx context.Context, contact *model.Contact) (*mod
 		query = `
 			insert into im_contact.contact(
 				domain_id, issuer_id, subject_id,
-				application_id, type, name, username, metadata, is_bot
+				application_id, type, name, username, metadata, is_bot,
+				created_by, updated_by
 			)
 			values(
 				@domain_id, @issuer_id, @subject_id,
-				@application_id, @type, @name, @username, @metadata, @is_bot
+				@application_id, @type, @name, @username, @metadata, @is_bot,
+				@created_by, @created_by
 			)
 			returning
-				id, domain_id, created_at, updated_at, subject_id,
+				id, domain_id, created_at, updated_at, created_by, updated_by, subject_id,
 				issuer_id, application_id, type, name, username, metadata, is_bot
 		`
 		args = pgx.NamedArgs{
@@ -55,6 +58,7 @@ func (c *contactStore) Create(ctx context.Context, contact *model.Contact) (*mod
 			"username":       contact.Username,
 			"metadata":       contact.Metadata,
 			"is_bot":         contact.IsBot,
+			"created_by":     contact.CreatedBy,
 		}
 		result *model.Contact
 	)
@@ -104,7 +108,7 @@ func (c *contactStore) Search(ctx context.Context, filter *model.ContactSearchRe
 		return nil, err
 	}
 
-	rows, err := c.db.Master().Query(ctx, stmt, args...)
+	rows, err := c.db.Reader(ctx).Query(ctx, stmt, args...)
 	if err != nil {
 		return nil, errors.Internal("querying contact search query", errors.WithCause(err), errors.WithID("postgres.contact_store.search"))
 	}
@@ -122,6 +126,55 @@ func (c *contactStore) Search(ctx context.Context, filter *model.ContactSearchRe
 	return contacts, nil
 }
 
+// FindByIDs implements [store.ContactStore].
+func (c *contactStore) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Contact, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return c.Search(ctx, &model.ContactSearchRequest{IDs: ids})
+}
+
+// ResolveByVia implements [store.ContactStore]. It is served by the via_lookup_idx index.
+func (c *contactStore) ResolveByVia(ctx context.Context, request *model.ResolveByViaRequest) ([]*model.ResolvedContact, error) {
+	fields := (new(model.Contact)).DefaultFields()
+
+	columns := make([]string, 0, len(fields)+1)
+	for _, field := range fields {
+		columns = append(columns, Ident("c", field))
+	}
+
+	columns = append(columns, "to_jsonb(v.*) as matched_via")
+
+	sb := sq.Select(columns...).
+		From("im_contact.via v").
+		Join("im_contact.contact c on c.id = v.contact_id").
+		Where(sq.Eq{"v.via": request.Values, "c.domain_id": request.DomainID}).
+		OrderBy("v.is_primary desc", "v.priority", "v.created_at").
+		PlaceholderFormat(sq.Dollar)
+
+	if request.Kind != "" {
+		sb = sb.Where(sq.Eq{"v.kind": request.Kind})
+	}
+
+	stmt, args, err := sb.ToSql()
+	if err != nil {
+		return nil, errors.Internal("preparing resolve by via stmt", errors.WithCause(err), errors.WithID("postgres.contact_store.resolve_by_via"))
+	}
+
+	rows, err := c.db.Reader(ctx).Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, errors.Internal("executing resolve by via stmt", errors.WithCause(err), errors.WithID("postgres.contact_store.resolve_by_via"))
+	}
+
+	resolved, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByNameLax[model.ResolvedContact])
+	if err != nil {
+		return nil, errors.Internal("collecting resolve by via result", errors.WithCause(err), errors.WithID("postgres.contact_store.resolve_by_via"))
+	}
+
+	return resolved, nil
+}
+
 func (c *contactStore) prepareContactSearchQuery(filter *model.ContactSearchRequest) (string, []any, error) {
 	const (
 		contactAlias string = "c"
@@ -142,7 +195,7 @@ func (c *contactStore) prepareContactSearchQuery(filter *model.ContactSearchRequ
 
 		selectBuilder = selectBuilder.LeftJoin(
 			`lateral (
-				select jsonb_agg(to_jsonb(v.*)) as via
+				select jsonb_agg(to_jsonb(v.*) order by v.is_primary desc, v.priority, v.created_at) as via
 				from "im_contact"."via" v
 				where v.contact_id = c.id
 			) v on true`,
@@ -222,6 +275,10 @@ func (c *contactStore) prepareContactSearchQuery(filter *model.ContactSearchRequ
 		contactSelect = contactSelect.Where(sq.Eq{Ident(contactAlias, "subject_id"): filter.Subjects})
 	}
 
+	if len(filter.CreatedBy) > 0 {
+		contactSelect = contactSelect.Where(sq.Eq{Ident(contactAlias, "created_by"): filter.CreatedBy})
+	}
+
 	if filter.OnlyBots != nil {
 		contactSelect = contactSelect.Where(sq.Eq{Ident(contactAlias, "is_bot"): *filter.OnlyBots})
 	}
@@ -239,6 +296,16 @@ func (c *contactStore) prepareContactSearchQuery(filter *model.ContactSearchRequ
 		}
 	}
 
+	if filter.InitiatorContactID != uuid.Nil {
+		contactSelect = contactSelect.Where(sq.Or{
+			sq.Eq{Ident(contactAlias, "id"): filter.InitiatorContactID},
+			sq.Expr(`not exists (
+				select 1 from im_contact.contact_setting s
+				where s.contact_id = c.id and not s.discoverable
+			)`),
+		})
+	}
+
 	stmt, args, err := contactSelect.ToSql()
 	if err != nil {
 		return "", nil, errors.New("building stmt for contact search", errors.WithCause(err), errors.WithCode(codes.FailedPrecondition), errors.WithID("postgres.contact_store.prepare_contact_search_query"))
@@ -257,19 +324,21 @@ func (c *contactStore) Update(ctx context.Context, updater *model.UpdateContactR
 				username = coalesce(@username, username),
 				metadata = coalesce(@metadata, metadata),
 				subject_id = coalesce(@subject, subject_id),
+				updated_by = @updated_by,
 				updated_at = now()
 			where domain_id = @domain_id
 				and id = @id
-			returning id, domain_id, created_at, updated_at, subject_id,
+			returning id, domain_id, created_at, updated_at, created_by, updated_by, subject_id,
 				issuer_id, application_id, type, name, username, metadata
 		`
 		args = pgx.NamedArgs{
-			"id":        updater.ID,
-			"domain_id": updater.DomainID,
-			"name":      updater.Name,
-			"username":  updater.Username,
-			"metadata":  updater.Metadata,
-			"subject":   updater.Subject,
+			"id":         updater.ID,
+			"domain_id":  updater.DomainID,
+			"name":       updater.Name,
+			"username":   updater.Username,
+			"metadata":   updater.Metadata,
+			"subject":    updater.Subject,
+			"updated_by": updater.UpdatedBy,
 		}
 		result *model.Contact
 	)
@@ -286,40 +355,54 @@ func (c *contactStore) Update(ctx context.Context, updater *model.UpdateContactR
 	return result, nil
 }
 
-func (c *contactStore) ClearByDomain(ctx context.Context, domainID int) error {
+func (c *contactStore) ClearByDomain(ctx context.Context, domainID int) ([]uuid.UUID, error) {
 	var (
 		query = `
 			delete from im_contact.contact
 			where domain_id = @domain_id
+			returning id
 		`
 		args = pgx.NamedArgs{
 			"domain_id": domainID,
 		}
 	)
 
-	if _, err := c.db.Master().Exec(ctx, query, args); err != nil {
-		return fmt.Errorf("contactStore.ClearByDomain (id = %d): %w", domainID, err)
+	rows, err := c.db.Master().Query(ctx, query, args)
+	if err != nil {
+		return nil, fmt.Errorf("contactStore.ClearByDomain (id = %d): %w", domainID, err)
 	}
 
-	return nil
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, fmt.Errorf("contactStore.ClearByDomain (id = %d): %w", domainID, err)
+	}
+
+	return ids, nil
 }
 
-func (c *contactStore) DeleteBotByFlowID(ctx context.Context, flowID string) error {
+func (c *contactStore) DeleteBotByFlowID(ctx context.Context, flowID string) ([]uuid.UUID, error) {
 	var (
 		query = `
 			delete from im_contact.contact
 			where is_bot = TRUE AND subject_id = @flow_id
+			returning id
 		`
 		args = pgx.NamedArgs{
 			"flow_id": flowID,
 		}
 	)
 
-	if _, err := c.db.Master().Exec(ctx, query, args); err != nil {
-		return errors.Internal("error occurred while executing query", errors.WithCause(err))
+	rows, err := c.db.Master().Query(ctx, query, args)
+	if err != nil {
+		return nil, errors.Internal("error occurred while executing query", errors.WithCause(err))
 	}
 
-	return nil
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[uuid.UUID])
+	if err != nil {
+		return nil, errors.Internal("error occurred while collecting deleted bots", errors.WithCause(err))
+	}
+
+	return ids, nil
 }
 
 func (c *contactStore) PartialUpdate(ctx context.Context, query queries.Query) (*model.Contact, error) {
@@ -349,17 +432,19 @@ func (c *contactStore) Upsert(ctx context.Context, contact *model.Contact) (*mod
 	stmt := `
 		with ins as (
 			insert into "im_contact"."contact" (
-				"domain_id", "issuer_id", "subject_id", "application_id", "type", "name", "username", "metadata"
+				"domain_id", "issuer_id", "subject_id", "application_id", "type", "name", "username", "metadata",
+				"created_by", "updated_by"
 			)
 			values (
-				@DomainID, @Iss, @Sub, @App, @Type, @Name, @Username, @Metadata
+				@DomainID, @Iss, @Sub, @App, @Type, @Name, @Username, @Metadata, @CreatedBy, @CreatedBy
 			)
 			on conflict ("domain_id", "issuer_id", "subject_id")
 			do update set
 				"updated_at" = now(),
 				"name" = excluded.name,
 			 	"username" = excluded.username,
-				"metadata" = excluded.metadata
+				"metadata" = excluded.metadata,
+				"updated_by" = excluded.updated_by
 			where
 				("im_contact"."contact"."name", "im_contact"."contact"."username", "im_contact"."contact"."metadata")
 				is distinct from
@@ -369,6 +454,8 @@ func (c *contactStore) Upsert(ctx context.Context, contact *model.Contact) (*mod
 				domain_id,
 				created_at,
 				updated_at,
+				created_by,
+				updated_by,
 				issuer_id,
 				application_id,
 				subject_id,
@@ -386,6 +473,8 @@ func (c *contactStore) Upsert(ctx context.Context, contact *model.Contact) (*mod
 			domain_id,
 			created_at,
 			updated_at,
+			created_by,
+			updated_by,
 			issuer_id,
 			application_id,
 			subject_id,
@@ -403,14 +492,15 @@ func (c *contactStore) Upsert(ctx context.Context, contact *model.Contact) (*mod
 		);
 	`
 	args := pgx.NamedArgs{
-		"DomainID": contact.DomainID,
-		"Iss":      contact.IssuerID,
-		"Sub":      contact.SubjectID,
-		"App":      contact.ApplicationID,
-		"Type":     contact.Type,
-		"Name":     contact.Name,
-		"Username": contact.Username,
-		"Metadata": contact.Metadata,
+		"DomainID":  contact.DomainID,
+		"Iss":       contact.IssuerID,
+		"Sub":       contact.SubjectID,
+		"App":       contact.ApplicationID,
+		"Type":      contact.Type,
+		"Name":      contact.Name,
+		"Username":  contact.Username,
+		"Metadata":  contact.Metadata,
+		"CreatedBy": contact.CreatedBy,
 	}
 
 	var (
@@ -423,6 +513,8 @@ func (c *contactStore) Upsert(ctx context.Context, contact *model.Contact) (*mod
 		&result.DomainID,
 		&result.CreatedAt,
 		&result.UpdatedAt,
+		&result.CreatedBy,
+		&result.UpdatedBy,
 		&result.IssuerID,
 		&result.ApplicationID,
 		&result.SubjectID,
@@ -452,7 +544,7 @@ func (c *contactStore) Locate(ctx context.Context, locate *model.LocateContactRe
 		)
 	}
 
-	rows, err := c.db.Master().Query(ctx, stmt, args...)
+	rows, err := c.db.Reader(ctx).Query(ctx, stmt, args...)
 	if err != nil {
 		return nil, errors.Internal("executing locate contact stmt", errors.WithCause(err), errors.WithID("postgres.contact_store.locate"))
 	}
@@ -484,7 +576,7 @@ func (c *contactStore) prepareLocateContactStore(locate *model.LocateContactRequ
 	locateContactSelectBuilder := sq.Select(fields...).From("im_contact.contact c").Limit(1).PlaceholderFormat(sq.Dollar)
 	locateContactSelectBuilder = locateContactSelectBuilder.LeftJoin(`
 		lateral (
-			select jsonb_agg(to_jsonb(cv.*)) as via
+			select jsonb_agg(to_jsonb(cv.*) order by cv.is_primary desc, cv.priority, cv.created_at) as via
 			from im_contact.via cv
 			where cv.contact_id = c.id
 		) v on true
@@ -17,5 +17,9 @@ var Module = fx.Module("store",
 			NewSettingsStore,
 			fx.As(new(store.SettingsStore)),
 		),
-		fx.Annotate(newViaStore, fx.As(new(store.ViaStore))),
+		fx.Annotate(NewViaStore, fx.As(new(store.ViaStore))),
+		fx.Annotate(NewSettingsTemplateStore, fx.As(new(store.SettingsTemplateStore))),
+		fx.Annotate(newPrivacyRuleStore, fx.As(new(store.PrivacyRuleStore))),
+		fx.Annotate(NewViaVerificationStore, fx.As(new(store.ViaVerificationStore))),
+		fx.Annotate(NewViaConsentStore, fx.As(new(store.ViaConsentStore))),
 	))
@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/infra/db/pg"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+var _ store.PrivacyRuleStore = (*privacyRuleStore)(nil)
+
+type privacyRuleStore struct {
+	db *pg.PgxDB
+}
+
+func newPrivacyRuleStore(db *pg.PgxDB) *privacyRuleStore {
+	return &privacyRuleStore{db: db}
+}
+
+// Create implements [store.PrivacyRuleStore].
+func (s *privacyRuleStore) Create(ctx context.Context, rule *model.PrivacyRule) (*model.PrivacyRule, error) {
+	var created model.PrivacyRule
+
+	err := pgxscan.Get(
+		ctx,
+		s.db.Master(),
+		&created,
+		`INSERT INTO im_contact.privacy_rule(domain_id, name, expression, scope)
+		 VALUES ($1, $2, $3, $4)
+		 RETURNING id, domain_id, name, expression, scope, created_at`,
+		rule.DomainID,
+		rule.Name,
+		rule.Expression,
+		rule.Scope,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return nil, errors.New("conflict: privacy rule already exists", errors.WithCause(err), errors.WithCode(codes.AlreadyExists), errors.WithID("postgres.privacy_rule_store.create"))
+		}
+
+		return nil, errors.Internal("inserting privacy rule", errors.WithCause(err), errors.WithID("postgres.privacy_rule_store.create"))
+	}
+
+	return &created, nil
+}
+
+// List implements [store.PrivacyRuleStore].
+func (s *privacyRuleStore) List(ctx context.Context, domainID int) ([]*model.PrivacyRule, error) {
+	var rules []*model.PrivacyRule
+
+	err := pgxscan.Select(
+		ctx,
+		s.db.Reader(ctx),
+		&rules,
+		`SELECT id, domain_id, name, expression, scope, created_at
+		 FROM im_contact.privacy_rule
+		 WHERE domain_id = $1
+		 ORDER BY created_at`,
+		domainID,
+	)
+	if err != nil {
+		return nil, errors.Internal("selecting privacy rules", errors.WithCause(err), errors.WithID("postgres.privacy_rule_store.list"))
+	}
+
+	return rules, nil
+}
+
+// Delete implements [store.PrivacyRuleStore].
+func (s *privacyRuleStore) Delete(ctx context.Context, domainID int, id uuid.UUID) error {
+	tag, err := s.db.Master().Exec(ctx, `DELETE FROM im_contact.privacy_rule WHERE domain_id = $1 AND id = $2`, domainID, id)
+	if err != nil {
+		return errors.Internal("deleting privacy rule", errors.WithCause(err), errors.WithID("postgres.privacy_rule_store.delete"))
+	}
+
+	if tag.RowsAffected() == 0 {
+		return errors.NotFound("privacy rule doesn`t exist", errors.WithID("postgres.privacy_rule_store.delete"))
+	}
+
+	return nil
+}
@@ -42,17 +42,38 @@ func (s *SettingsStore) Create(ctx context.Context, command *model.CreateContact
 		return nil, errors.InvalidArgument("contact id required to create settings")
 	}
 
-	_, err := s.db.Master().Exec(
+	var created model.ContactSettings
+
+	err := pgxscan.Get(
 		ctx,
-		`INSERT INTO im_contact.contact_setting(contact_id, allow_invites_from) VALUES ($1, $2)`,
+		s.db.Master(),
+		&created,
+		`INSERT INTO im_contact.contact_setting(contact_id, allow_invites_from, allow_messages_from, allowed_contacts, discoverable, created_by, updated_by)
+		 VALUES (
+		     $1, $2, $3, coalesce($4::uuid[], '{}'),
+		     coalesce(
+		         $5::boolean,
+		         (SELECT t.discoverable
+		          FROM im_contact.contact c
+		          JOIN im_contact.settings_template t ON t.domain_id = c.domain_id
+		          WHERE c.id = $1),
+		         true
+		     ),
+		     $6, $6
+		 )
+		 RETURNING id, updated_at, contact_id, allow_invites_from, allow_messages_from, allowed_contacts, discoverable, created_by, updated_by`,
 		command.ContactID,
 		command.Settings.AllowInvitesFrom,
+		command.Settings.AllowMessagesFrom,
+		command.Settings.AllowedContacts,
+		command.Discoverable,
+		command.CreatedBy,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	return command.Settings, nil
+	return &created, nil
 }
 
 // Get implements [store.SettingsStore].
@@ -65,9 +86,9 @@ func (s *SettingsStore) Get(ctx context.Context, contactID uuid.UUID) (*model.Co
 
 	err := pgxscan.Get(
 		ctx,
-		s.db.Master(),
+		s.db.Reader(ctx),
 		&settings,
-		"SELECT id, updated_at, contact_id, allow_invites_from FROM im_contact.contact_setting WHERE contact_id = $1",
+		"SELECT id, updated_at, contact_id, allow_invites_from, allow_messages_from, allowed_contacts, discoverable, created_by, updated_by FROM im_contact.contact_setting WHERE contact_id = $1",
 		contactID,
 	)
 	if err != nil {
@@ -77,34 +98,86 @@ func (s *SettingsStore) Get(ctx context.Context, contactID uuid.UUID) (*model.Co
 	return &settings, nil
 }
 
-// Update implements [store.SettingsStore].
-func (s *SettingsStore) Update(ctx context.Context, args *model.UpdateContactSettingsRequest) (*model.ContactSettings, error) {
+// GetMany implements [store.SettingsStore].
+func (s *SettingsStore) GetMany(ctx context.Context, contactIDs []uuid.UUID) ([]*model.ContactSettings, error) {
+	if len(contactIDs) == 0 {
+		return nil, nil
+	}
+
+	var settings []*model.ContactSettings
+
+	err := pgxscan.Select(
+		ctx,
+		s.db.Reader(ctx),
+		&settings,
+		"SELECT id, updated_at, contact_id, allow_invites_from, allow_messages_from, allowed_contacts, discoverable, created_by, updated_by FROM im_contact.contact_setting WHERE contact_id = ANY($1)",
+		contactIDs,
+	)
+	if err != nil {
+		return nil, errors.Internal("selecting settings", errors.WithCause(err), errors.WithID("postgres.settings_store.get_many"))
+	}
+
+	return settings, nil
+}
+
+// Update implements [store.SettingsStore]. The row is locked while it is read and changed in a single statement,
+// so that concurrent updates each get the values they actually replaced.
+func (s *SettingsStore) Update(ctx context.Context, args *model.UpdateContactSettingsRequest) (*model.ContactSettings, *model.ContactSettings, error) {
 	if args == nil {
-		return nil, errors.InvalidArgument("update settings request is required")
+		return nil, nil, errors.InvalidArgument("update settings request is required")
 	}
 
 	if args.ContactID == uuid.Nil {
-		return nil, errors.InvalidArgument("contact id required to update settings")
+		return nil, nil, errors.InvalidArgument("contact id required to update settings")
 	}
 
-	var updatedSettings model.ContactSettings
+	var old, updated model.ContactSettings
 
-	err := pgxscan.Get(
+	err := s.db.Master().QueryRow(
 		ctx,
-		s.db.Master(),
-		&updatedSettings,
-		`UPDATE im_contact.contact_setting
-		 SET allow_invites_from=coalesce($2, allow_invites_from),
-		 updated_at = NOW()
-	     WHERE contact_id = $1
-		 RETURNING id, updated_at, contact_id, allow_invites_from`,
+		`UPDATE im_contact.contact_setting s
+		 SET allow_invites_from = coalesce($2, s.allow_invites_from),
+		     allowed_contacts = coalesce($3::uuid[], s.allowed_contacts),
+		     allow_messages_from = coalesce($4, s.allow_messages_from),
+		     discoverable = coalesce($5, s.discoverable),
+		     updated_by = $6,
+		     updated_at = NOW()
+		 FROM (
+		     SELECT id, updated_at, contact_id, allow_invites_from, allow_messages_from, allowed_contacts, discoverable, created_by, updated_by
+		     FROM im_contact.contact_setting
+		     WHERE contact_id = $1
+		     FOR UPDATE
+		 ) old
+		 WHERE s.id = old.id
+		 RETURNING old.id, old.updated_at, old.contact_id, old.allow_invites_from, old.allow_messages_from,
+		           old.allowed_contacts, old.discoverable, old.created_by, old.updated_by,
+		           s.id, s.updated_at, s.contact_id, s.allow_invites_from, s.allow_messages_from,
+		           s.allowed_contacts, s.discoverable, s.created_by, s.updated_by`,
 
 		args.ContactID,
 		args.AllowInvitesFrom,
-	)
+		args.AllowedContacts,
+		args.AllowMessagesFrom,
+		args.Discoverable,
+		args.UpdatedBy,
+	).Scan(append(settingsScanTargets(&old), settingsScanTargets(&updated)...)...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return &updatedSettings, nil
+	return &old, &updated, nil
+}
+
+func settingsScanTargets(settings *model.ContactSettings) []any {
+	return []any{
+		&settings.ID,
+		&settings.UpdatedAt,
+		&settings.ContactID,
+		&settings.AllowInvitesFrom,
+		&settings.AllowMessagesFrom,
+		&settings.AllowedContacts,
+		&settings.Discoverable,
+		&settings.CreatedBy,
+		&settings.UpdatedBy,
+	}
 }
@@ -0,0 +1,149 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/infra/db/pg"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+var _ store.SettingsTemplateStore = (*settingsTemplateStore)(nil)
+
+type settingsTemplateStore struct {
+	db *pg.PgxDB
+}
+
+func NewSettingsTemplateStore(db *pg.PgxDB) *settingsTemplateStore {
+	return &settingsTemplateStore{db: db}
+}
+
+// Get implements [store.SettingsTemplateStore].
+func (s *settingsTemplateStore) Get(ctx context.Context, domainID int) (*model.SettingsTemplate, error) {
+	var template model.SettingsTemplate
+
+	err := pgxscan.Get(
+		ctx,
+		s.db.Reader(ctx),
+		&template,
+		`SELECT domain_id, allow_invites_from, allow_messages_from, discoverable, locked_fields, updated_at
+		 FROM im_contact.settings_template
+		 WHERE domain_id = $1`,
+		domainID,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, errors.Internal("selecting settings template", errors.WithCause(err), errors.WithID("postgres.settings_template_store.get"))
+	}
+
+	return &template, nil
+}
+
+// Upsert implements [store.SettingsTemplateStore]. Settings of the domain contacts get the locked values
+// in the same transaction, so that a lock also covers values chosen before it was set.
+func (s *settingsTemplateStore) Upsert(ctx context.Context, template *model.SettingsTemplate) (*model.SettingsTemplate, []*model.SettingsChange, error) {
+	var (
+		saved    model.SettingsTemplate
+		enforced []*model.SettingsChange
+	)
+
+	err := pgx.BeginFunc(ctx, s.db.Master(), func(tx pgx.Tx) error {
+		err := pgxscan.Get(
+			ctx,
+			tx,
+			&saved,
+			`INSERT INTO im_contact.settings_template(domain_id, allow_invites_from, allow_messages_from, discoverable, locked_fields)
+			 VALUES ($1, $2, $3, $4, coalesce($5::text[], '{}'))
+			 ON CONFLICT (domain_id) DO UPDATE
+			 SET allow_invites_from = excluded.allow_invites_from,
+			     allow_messages_from = excluded.allow_messages_from,
+			     discoverable = excluded.discoverable,
+			     locked_fields = excluded.locked_fields,
+			     updated_at = now()
+			 RETURNING domain_id, allow_invites_from, allow_messages_from, discoverable, locked_fields, updated_at`,
+			template.DomainID,
+			template.AllowInvitesFrom,
+			template.AllowMessagesFrom,
+			template.Discoverable,
+			template.LockedFields,
+		)
+		if err != nil {
+			return err
+		}
+
+		if len(saved.LockedFields) == 0 {
+			return nil
+		}
+
+		// updated_by 0 marks the change as made by the service rather than a user;
+		// the old values are read under the row locks, so they are exactly the ones replaced
+		rows, err := tx.Query(
+			ctx,
+			`WITH old AS (
+			     SELECT s.id, s.updated_at, s.contact_id, s.allow_invites_from, s.allow_messages_from,
+			            s.allowed_contacts, s.discoverable, s.created_by, s.updated_by
+			     FROM im_contact.contact_setting s
+			     JOIN im_contact.contact c ON c.id = s.contact_id
+			     JOIN im_contact.settings_template t ON t.domain_id = c.domain_id
+			     WHERE c.domain_id = @DomainID
+			       AND (   (@InvitesField = ANY(t.locked_fields) AND s.allow_invites_from <> t.allow_invites_from)
+			            OR (@MessagesField = ANY(t.locked_fields) AND s.allow_messages_from <> t.allow_messages_from)
+			            OR (@DiscoverableField = ANY(t.locked_fields) AND s.discoverable <> t.discoverable))
+			     FOR UPDATE OF s
+			 )
+			 UPDATE im_contact.contact_setting s
+			 SET allow_invites_from = CASE WHEN @InvitesField = ANY(t.locked_fields) THEN t.allow_invites_from ELSE s.allow_invites_from END,
+			     allow_messages_from = CASE WHEN @MessagesField = ANY(t.locked_fields) THEN t.allow_messages_from ELSE s.allow_messages_from END,
+			     discoverable = CASE WHEN @DiscoverableField = ANY(t.locked_fields) THEN t.discoverable ELSE s.discoverable END,
+			     updated_by = 0,
+			     updated_at = now()
+			 FROM old, im_contact.settings_template t
+			 WHERE s.id = old.id
+			   AND t.domain_id = @DomainID
+			 RETURNING old.id, old.updated_at, old.contact_id, old.allow_invites_from, old.allow_messages_from,
+			           old.allowed_contacts, old.discoverable, old.created_by, old.updated_by,
+			           s.id, s.updated_at, s.contact_id, s.allow_invites_from, s.allow_messages_from,
+			           s.allowed_contacts, s.discoverable, s.created_by, s.updated_by`,
+			pgx.NamedArgs{
+				"DomainID":          saved.DomainID,
+				"InvitesField":      model.SettingsFieldAllowInvitesFrom,
+				"MessagesField":     model.SettingsFieldAllowMessagesFrom,
+				"DiscoverableField": model.SettingsFieldDiscoverable,
+			},
+		)
+		if err != nil {
+			return err
+		}
+
+		enforced, err = pgx.CollectRows(rows, func(row pgx.CollectableRow) (*model.SettingsChange, error) {
+			change := &model.SettingsChange{Old: new(model.ContactSettings), Updated: new(model.ContactSettings)}
+
+			return change, row.Scan(append(settingsScanTargets(change.Old), settingsScanTargets(change.Updated)...)...)
+		})
+
+		return err
+	})
+	if err != nil {
+		return nil, nil, errors.Internal("upserting settings template", errors.WithCause(err), errors.WithID("postgres.settings_template_store.upsert"))
+	}
+
+	return &saved, enforced, nil
+}
+
+// Delete implements [store.SettingsTemplateStore].
+func (s *settingsTemplateStore) Delete(ctx context.Context, domainID int) error {
+	_, err := s.db.Master().Exec(ctx, `DELETE FROM im_contact.settings_template WHERE domain_id = $1`, domainID)
+	if err != nil {
+		return errors.Internal("deleting settings template", errors.WithCause(err), errors.WithID("postgres.settings_template_store.delete"))
+	}
+
+	return nil
+}
@@ -3,9 +3,12 @@ package postgres
 import (
 	"context"
 	"slices"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"google.golang.org/grpc/codes"
 
 	"github.com/webitel/webitel-go-kit/pkg/errors"
 
@@ -13,11 +16,21 @@ import (
 	"github.com/webitel/im-contact-service/internal/model"
 )
 
+const (
+	// viaDomainColumn resolves the domain of the via owner, vias don't store it themselves.
+	viaDomainColumn = `(select c."domain_id" from "im_contact"."contact" c where c."id" = "contact_id") as "domain_id"`
+
+	viaReturningColumns = `v."contact_id", v."via", v."disable", v."disable_reason", v."created_at", v."updated_at",
+	v."metadata", v."verified_at", v."kind", v."is_primary", v."priority", v."failure_count", v."last_failure_at",
+	v."disabled_until", v."created_by", v."updated_by",
+	(select c."domain_id" from "im_contact"."contact" c where c."id" = v."contact_id") as "domain_id"`
+)
+
 type via struct {
 	db *pg.PgxDB
 }
 
-func newViaStore(db *pg.PgxDB) *via {
+func NewViaStore(db *pg.PgxDB) *via {
 	return &via{db: db}
 }
 
@@ -38,6 +51,18 @@ func (communicationStore *via) Create(ctx context.Context, communication *model.
 	savedCommunication, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
 	if err != nil {
 		if ok, rerr := pg.ErrorIntegrityViolation(err); ok {
+			if errors.Code(rerr) == codes.AlreadyExists {
+				// vias are keyed by value, whatever their kind
+				return nil, errors.New(
+					"via is already registered for the contact, a value can have only one kind per contact, e.g. a number can't be both a phone and a whatsapp via",
+					errors.WithCause(err),
+					errors.WithCode(codes.AlreadyExists),
+					errors.WithID("postgres.communication.create"),
+					errors.WithValue("contact_id", communication.ContactID.String()),
+					errors.WithValue("via", communication.Via),
+				)
+			}
+
 			return nil, errors.Wrap(
 				rerr,
 				errors.WithID("postgres.communication.create"),
@@ -65,20 +90,27 @@ func (communicationStore *via) prepareCreateStmt(communication *model.CreateViaC
 			from im_contact.contact c
 			where (@Iss::text is not null and c.issuer_id = @Iss)
 			and (@Sub::text is not null and c.subject_id = @Sub)
+			and (@DomainID::int = 0 or c.domain_id = @DomainID)
 			limit 1
 		)
 		insert into "im_contact"."via" (
-			"contact_id", "via", "disable", "disable_reason", "metadata"
+			"contact_id", "via", "disable", "disable_reason", "metadata", "kind", "priority", "disabled_until",
+			"created_by", "updated_by"
 		)
 		values (
 			coalesce(@ContactID, (select tc.id from target_contact tc)),
 			@Via,
 			@Disable,
 			@DisableReason,
-			@Metadata
+			@Metadata,
+			@Kind,
+			@Priority,
+			@DisabledUntil,
+			@CreatedBy,
+			@CreatedBy
 		)
-		returning "contact_id", "via", "disable","disable_reason", "metadata", "created_at", "updated_at";
-	`
+		returning "contact_id", "via", "disable","disable_reason", "metadata", "created_at", "updated_at", "verified_at", "kind", "is_primary", "priority",
+			"failure_count", "last_failure_at", "disabled_until", "created_by", "updated_by", ` + viaDomainColumn + `;`
 
 	args := pgx.NamedArgs{
 		"ContactID":     communication.GetContactIDPtr(),
@@ -86,22 +118,29 @@ func (communicationStore *via) prepareCreateStmt(communication *model.CreateViaC
 		"Disable":       communication.Disable,
 		"DisableReason": communication.DisableReason,
 		"Metadata":      communication.Metadata,
+		"Kind":          communication.Kind,
+		"Priority":      communication.Priority,
+		"DisabledUntil": communication.DisabledUntil,
 		"Iss":           communication.GetIssPtr(),
 		"Sub":           communication.GetSubPtr(),
+		"DomainID":      communication.DomainID,
+		"CreatedBy":     communication.CreatedBy,
 	}
 
 	return query, args
 }
 
-func (communicationStore *via) Update(ctx context.Context, communication *model.ViaCommunication) (*model.ViaCommunication, error) {
+func (communicationStore *via) Update(ctx context.Context, communication *model.ViaCommunication) (*model.ViaChangeResult, error) {
 	stmt, args := communicationStore.prepareUpdateStmt(communication)
 
-	rows, err := communicationStore.db.Master().Query(ctx, stmt, args)
-	if err != nil {
-		return nil, errors.Internal("executing update communication option", errors.WithCause(err), errors.WithID("postgres.communication.update"))
-	}
+	result, err := communicationStore.change(ctx, communication.ContactID, communication.Via, communication.UpdatedBy, false, func(tx pgx.Tx) (*model.ViaCommunication, error) {
+		rows, err := tx.Query(ctx, stmt, args)
+		if err != nil {
+			return nil, err
+		}
 
-	updated, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+		return pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+	})
 	if err != nil {
 		if ok, rerr := pg.ErrorIntegrityViolation(err); ok {
 			return nil, errors.Wrap(rerr, errors.WithID("postgres.communication.update"), errors.WithValue("contact_id", communication.ContactID.String()))
@@ -111,10 +150,10 @@ func (communicationStore *via) Update(ctx context.Context, communication *model.
 			return nil, errors.NotFound("zero records found for update", errors.WithCause(err), errors.WithID("postgres.communication.update"))
 		}
 
-		return nil, errors.Internal("collecting communication record row", errors.WithCause(err), errors.WithID("postgres.communication.update"), errors.WithValue("contact_id", communication.ContactID.String()))
+		return nil, errors.Internal("updating communication", errors.WithCause(err), errors.WithID("postgres.communication.update"), errors.WithValue("contact_id", communication.ContactID.String()))
 	}
 
-	return updated, nil
+	return result, nil
 }
 
 func (communicationStore *via) prepareUpdateStmt(communication *model.ViaCommunication) (string, pgx.NamedArgs) {
@@ -123,7 +162,15 @@ func (communicationStore *via) prepareUpdateStmt(communication *model.ViaCommuni
 		set
 			"disable"=@Disable,
 			"disable_reason"=@DisableReason,
-			"metadata" = @Metadata
+			"metadata" = @Metadata,
+			"kind" = coalesce(nullif(@Kind::text, ''), "kind"),
+			-- a via that moves to another kind is not the primary one of it
+			"is_primary" = "is_primary" and "kind" = coalesce(nullif(@Kind::text, ''), "kind"),
+			"priority" = @Priority,
+			"disabled_until" = @DisabledUntil,
+			"updated_by" = @UpdatedBy,
+			-- re-enabling a via starts counting delivery failures anew
+			"failure_count" = case when "disable" and not @Disable::bool then 0 else "failure_count" end
 		where ("contact_id","via") = (@ContactID, @Via)
 		returning
 			"contact_id",
@@ -132,8 +179,17 @@ func (communicationStore *via) prepareUpdateStmt(communication *model.ViaCommuni
 			"disable_reason",
 			"created_at",
 			"updated_at",
-			"metadata"
-	`
+			"metadata",
+			"verified_at",
+			"kind",
+			"is_primary",
+			"priority",
+			"failure_count",
+			"last_failure_at",
+			"disabled_until",
+			"created_by",
+			"updated_by",
+			` + viaDomainColumn
 
 	args := pgx.NamedArgs{
 		"Via":           communication.Via,
@@ -141,32 +197,42 @@ func (communicationStore *via) prepareUpdateStmt(communication *model.ViaCommuni
 		"DisableReason": communication.DisableReason,
 		"Metadata":      communication.Metadata,
 		"ContactID":     communication.ContactID,
+		"Kind":          communication.Kind,
+		"Priority":      communication.Priority,
+		"DisabledUntil": communication.DisabledUntil,
+		"UpdatedBy":     communication.UpdatedBy,
 	}
 
 	return stmt, args
 }
 
-func (communicationStore *via) PartialUpdate(ctx context.Context, updateCommand *model.CommunicationViaPartialUpdateCmd) (*model.ViaCommunication, error) {
+func (communicationStore *via) PartialUpdate(ctx context.Context, updateCommand *model.CommunicationViaPartialUpdateCmd) (*model.ViaChangeResult, error) {
 	stmt, args, err := communicationStore.preparePartialUpdateStmt(updateCommand)
 	if err != nil {
 		return nil, err
 	}
 
-	rows, err := communicationStore.db.Master().Query(ctx, stmt, args...)
-	if err != nil {
-		return nil, errors.Internal("executing partial update query", errors.WithCause(err), errors.WithID("postgres.communication.partial_update"))
-	}
+	result, err := communicationStore.change(ctx, updateCommand.ContactID, updateCommand.Via, updateCommand.UpdatedBy, false, func(tx pgx.Tx) (*model.ViaCommunication, error) {
+		rows, err := tx.Query(ctx, stmt, args...)
+		if err != nil {
+			return nil, err
+		}
 
-	updated, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+		return pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+	})
 	if err != nil {
 		if ok, rerr := pg.ErrorIntegrityViolation(err); ok {
 			return nil, errors.Wrap(rerr, errors.WithID("postgres.communication.partial_update"), errors.WithValue("contact_id", updateCommand.ContactID.String()))
 		}
 
-		return nil, errors.Internal("collecting partial update query result records", errors.WithCause(err), errors.WithID("postgres.communication.partial_update"))
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("zero records found for partial update", errors.WithCause(err), errors.WithID("postgres.communication.partial_update"))
+		}
+
+		return nil, errors.Internal("partially updating communication", errors.WithCause(err), errors.WithID("postgres.communication.partial_update"))
 	}
 
-	return updated, nil
+	return result, nil
 }
 
 func (communicationStore *via) preparePartialUpdateStmt(updateCommand *model.CommunicationViaPartialUpdateCmd) (string, []any, error) {
@@ -175,19 +241,39 @@ func (communicationStore *via) preparePartialUpdateStmt(updateCommand *model.Com
 	for _, field := range updateCommand.Fields {
 		switch field {
 		case "disable":
-			communicationUpdateBuilder = communicationUpdateBuilder.Set("disable", updateCommand.Disable)
+			communicationUpdateBuilder = communicationUpdateBuilder.
+				Set("disable", updateCommand.Disable).
+				Set("failure_count", sq.Expr("case when disable and not ? then 0 else failure_count end", updateCommand.Disable))
+
+			// enabling a via ends its suspension unless a new one is given
+			if !updateCommand.Disable && !slices.Contains(updateCommand.Fields, "disabled_until") {
+				communicationUpdateBuilder = communicationUpdateBuilder.Set("disabled_until", nil)
+			}
+		case "disabled_until":
+			communicationUpdateBuilder = communicationUpdateBuilder.Set("disabled_until", updateCommand.DisabledUntil)
 		case "disable_reason":
 			communicationUpdateBuilder = communicationUpdateBuilder.Set("disable_reason", updateCommand.DisableReason)
 		case "metadata":
 			communicationUpdateBuilder = communicationUpdateBuilder.Set("metadata", updateCommand.Metadata)
+		case "kind":
+			if !updateCommand.Kind.Valid() {
+				return "", nil, errors.InvalidArgument("kind is required to update it", errors.WithID("postgres.communication.prepare_partial_update_stmt"))
+			}
+
+			communicationUpdateBuilder = communicationUpdateBuilder.
+				Set("kind", updateCommand.Kind).
+				Set("is_primary", sq.Expr("is_primary and kind = ?", updateCommand.Kind))
+		case "priority":
+			communicationUpdateBuilder = communicationUpdateBuilder.Set("priority", updateCommand.Priority)
 		default:
 			return "", nil, errors.InvalidArgument("unsupported field: "+field, errors.WithID("postgres.communication.prepare_partial_update_stmt"))
 		}
 	}
 
+	communicationUpdateBuilder = communicationUpdateBuilder.Set("updated_by", updateCommand.UpdatedBy)
 	communicationUpdateBuilder = communicationUpdateBuilder.Where(sq.Eq{"contact_id": updateCommand.ContactID})
 	communicationUpdateBuilder = communicationUpdateBuilder.Where(sq.Eq{"via": updateCommand.Via})
-	communicationUpdateBuilder = communicationUpdateBuilder.Suffix("returning contact_id, via, disable, disable_reason, created_at, updated_at, metadata")
+	communicationUpdateBuilder = communicationUpdateBuilder.Suffix("returning contact_id, via, disable, disable_reason, created_at, updated_at, metadata, verified_at, kind, is_primary, priority, failure_count, last_failure_at, disabled_until, created_by, updated_by, " + viaDomainColumn)
 
 	stmt, args, err := communicationUpdateBuilder.ToSql()
 	if err != nil {
@@ -203,7 +289,7 @@ func (communicationStore *via) Search(ctx context.Context, filter *model.SearchV
 		return nil, err
 	}
 
-	rows, err := communicationStore.db.Master().Query(ctx, stmt, args...)
+	rows, err := communicationStore.db.Reader(ctx).Query(ctx, stmt, args...)
 	if err != nil {
 		return nil, errors.Internal("executing search contact communication request", errors.WithCause(err), errors.WithID("postgres.communication.search"))
 	}
@@ -246,6 +332,12 @@ func (communicationStore *via) prepareSearchStmt(filter *model.SearchViaCommunic
 		sb = sb.OrderBy(sortingField + " " + sortOperator)
 	}
 
+	if filter.DomainID > 0 {
+		sb = sb.Where(
+			sq.Expr(Ident(communicationaAllias, "contact_id")+" in (select id from im_contact.contact where domain_id = ?)", filter.DomainID),
+		)
+	}
+
 	if len(filter.ContactIDs) > 0 {
 		sb = sb.Where(
 			sq.Eq{Ident(communicationaAllias, "contact_id"): filter.ContactIDs},
@@ -260,6 +352,32 @@ func (communicationStore *via) prepareSearchStmt(filter *model.SearchViaCommunic
 		sb = sb.Where(sq.Eq{Ident(communicationaAllias, "disable"): *disabled})
 	}
 
+	if len(filter.Kinds) > 0 {
+		sb = sb.Where(sq.Eq{Ident(communicationaAllias, "kind"): filter.Kinds})
+	}
+
+	const latestConsent = `(
+		select vc.status from im_contact.via_consent vc
+		where vc.contact_id = c.contact_id and vc.via = c.via
+		order by vc.occurred_at desc, vc.created_at desc
+		limit 1
+	)`
+
+	switch filter.Consent {
+	case model.ConsentFilterGranted, model.ConsentFilterRevoked:
+		sb = sb.Where(sq.Expr(latestConsent+" = ?", string(filter.Consent)))
+	case model.ConsentFilterUnknown:
+		sb = sb.Where(sq.Expr(latestConsent + " is null"))
+	}
+
+	if verified := filter.Verified; verified != nil {
+		if *verified {
+			sb = sb.Where(sq.NotEq{Ident(communicationaAllias, "verified_at"): nil})
+		} else {
+			sb = sb.Where(sq.Eq{Ident(communicationaAllias, "verified_at"): nil})
+		}
+	}
+
 	stmt, args, err := sb.ToSql()
 	if err != nil {
 		return "", nil, errors.InvalidArgument(
@@ -271,3 +389,599 @@ func (communicationStore *via) prepareSearchStmt(filter *model.SearchViaCommunic
 
 	return stmt, args, nil
 }
+
+func (communicationStore *via) Delete(ctx context.Context, deleteCommand *model.DeleteViaCommand) (*model.ViaChangeResult, error) {
+	const stmt = `
+		delete from "im_contact"."via"
+		where ("contact_id","via") = (@ContactID, @Via)
+		returning
+			"contact_id",
+			"via",
+			"disable",
+			"disable_reason",
+			"created_at",
+			"updated_at",
+			"metadata",
+			"verified_at",
+			"kind",
+			"is_primary",
+			"priority",
+			"failure_count",
+			"last_failure_at",
+			"disabled_until",
+			"created_by",
+			"updated_by",
+			` + viaDomainColumn
+
+	args := pgx.NamedArgs{
+		"ContactID": deleteCommand.ContactID,
+		"Via":       deleteCommand.Via,
+	}
+
+	result, err := communicationStore.change(ctx, deleteCommand.ContactID, deleteCommand.Via, deleteCommand.UpdatedBy, true, func(tx pgx.Tx) (*model.ViaCommunication, error) {
+		rows, err := tx.Query(ctx, stmt, args)
+		if err != nil {
+			return nil, err
+		}
+
+		return pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(
+				"zero records found for delete",
+				errors.WithCause(err),
+				errors.WithID("postgres.communication.delete"),
+				errors.WithValue("contact_id", deleteCommand.ContactID.String()),
+				errors.WithValue("via", deleteCommand.Via),
+			)
+		}
+
+		if ok, rerr := pg.ErrorIntegrityViolation(err); ok {
+			return nil, errors.Wrap(rerr, errors.WithID("postgres.communication.delete"))
+		}
+
+		return nil, errors.Internal("deleting communication", errors.WithCause(err), errors.WithID("postgres.communication.delete"))
+	}
+
+	return result, nil
+}
+
+// change runs apply on the locked via and, when the via was the primary one of its kind and no longer is,
+// by a kind change or by deletion, promotes the next via of that kind in the same transaction.
+// apply returns the changed via or, when removes is set, the deleted one.
+func (communicationStore *via) change(
+	ctx context.Context,
+	contactID uuid.UUID,
+	via string,
+	updatedBy int,
+	removes bool,
+	apply func(tx pgx.Tx) (*model.ViaCommunication, error),
+) (*model.ViaChangeResult, error) {
+	const lockStmt = `
+		select ` + viaReturningColumns + `
+		from "im_contact"."via" v
+		where (v."contact_id", v."via") = (@ContactID, @Via)
+		for update`
+
+	result := new(model.ViaChangeResult)
+
+	err := pgx.BeginFunc(ctx, communicationStore.db.Master(), func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, lockStmt, pgx.NamedArgs{"ContactID": contactID, "Via": via})
+		if err != nil {
+			return err
+		}
+
+		before, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+		if err != nil {
+			return err
+		}
+
+		result.Via, err = apply(tx)
+		if err != nil {
+			return err
+		}
+
+		if !before.Primary || (!removes && result.Via.Primary && result.Via.Kind == before.Kind) {
+			return nil
+		}
+
+		result.Promoted, err = promotePrimary(ctx, tx, contactID, before.Kind, updatedBy)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// lostPrimary returns the kinds whose primary via the plan deletes or moves to another kind.
+func lostPrimary(existing []*model.ViaCommunication, plan *model.ViaReplacePlan) []model.ViaKind {
+	var kinds []model.ViaKind
+
+	for _, via := range existing {
+		if !via.Primary {
+			continue
+		}
+
+		lost := slices.Contains(plan.Delete, via.Via) || slices.ContainsFunc(plan.Update, func(item *model.ReplaceViaItem) bool {
+			return item.Via == via.Via && item.Kind != via.Kind
+		})
+
+		if lost && !slices.Contains(kinds, via.Kind) {
+			kinds = append(kinds, via.Kind)
+		}
+	}
+
+	return kinds
+}
+
+// promotePrimary makes the next via of the kind primary when the kind has no primary via left.
+// Enabled vias go first, then the usual priority order. It returns nil when nothing was promoted.
+func promotePrimary(ctx context.Context, tx pgx.Tx, contactID uuid.UUID, kind model.ViaKind, updatedBy int) (*model.ViaCommunication, error) {
+	const stmt = `
+		update "im_contact"."via" v
+		set "is_primary" = true, "updated_by" = @UpdatedBy
+		where (v."contact_id", v."via") = (
+			select s."contact_id", s."via"
+			from "im_contact"."via" s
+			where s."contact_id" = @ContactID and s."kind" = @Kind
+			and not exists (
+				select 1 from "im_contact"."via" p
+				where p."contact_id" = @ContactID and p."kind" = @Kind and p."is_primary"
+			)
+			order by s."disable", s."priority", s."created_at"
+			limit 1
+		)
+		returning ` + viaReturningColumns
+
+	rows, err := tx.Query(ctx, stmt, pgx.NamedArgs{"ContactID": contactID, "Kind": kind, "UpdatedBy": updatedBy})
+	if err != nil {
+		return nil, err
+	}
+
+	promoted, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+	if err != nil || len(promoted) == 0 {
+		return nil, err
+	}
+
+	return promoted[0], nil
+}
+
+func (communicationStore *via) DeleteByContact(ctx context.Context, contactID uuid.UUID) ([]*model.ViaCommunication, error) {
+	const stmt = `
+		delete from "im_contact"."via"
+		where "contact_id" = @ContactID
+		returning
+			"contact_id",
+			"via",
+			"disable",
+			"disable_reason",
+			"created_at",
+			"updated_at",
+			"metadata",
+			"verified_at",
+			"kind",
+			"is_primary",
+			"priority",
+			"failure_count",
+			"last_failure_at",
+			"disabled_until",
+			"created_by",
+			"updated_by",
+			` + viaDomainColumn
+
+	rows, err := communicationStore.db.Master().Query(ctx, stmt, pgx.NamedArgs{"ContactID": contactID})
+	if err != nil {
+		return nil, errors.Internal("executing delete contact communications stmt", errors.WithCause(err), errors.WithID("postgres.communication.delete_by_contact"))
+	}
+
+	deleted, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+	if err != nil {
+		return nil, errors.Internal(
+			"collecting deleted contact communication rows",
+			errors.WithCause(err),
+			errors.WithID("postgres.communication.delete_by_contact"),
+			errors.WithValue("contact_id", contactID.String()),
+		)
+	}
+
+	return deleted, nil
+}
+
+func (communicationStore *via) MarkVerified(ctx context.Context, contactID uuid.UUID, via string, updatedBy int) (*model.ViaCommunication, error) {
+	const stmt = `
+		update "im_contact"."via"
+		set "verified_at" = now(), "updated_by" = @UpdatedBy
+		where ("contact_id","via") = (@ContactID, @Via)
+		returning
+			"contact_id",
+			"via",
+			"disable",
+			"disable_reason",
+			"created_at",
+			"updated_at",
+			"metadata",
+			"verified_at",
+			"kind",
+			"is_primary",
+			"priority",
+			"failure_count",
+			"last_failure_at",
+			"disabled_until",
+			"created_by",
+			"updated_by",
+			` + viaDomainColumn
+
+	rows, err := communicationStore.db.Master().Query(ctx, stmt, pgx.NamedArgs{"ContactID": contactID, "Via": via, "UpdatedBy": updatedBy})
+	if err != nil {
+		return nil, errors.Internal("executing mark verified stmt", errors.WithCause(err), errors.WithID("postgres.communication.mark_verified"))
+	}
+
+	verified, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound("zero records found for verification", errors.WithCause(err), errors.WithID("postgres.communication.mark_verified"))
+		}
+
+		return nil, errors.Internal("collecting verified communication row", errors.WithCause(err), errors.WithID("postgres.communication.mark_verified"))
+	}
+
+	return verified, nil
+}
+
+// SetPrimary makes the via the only primary one among the contact vias of its kind.
+// Demoting the previous primary and promoting the new one share a transaction,
+// so the (contact_id, kind) unique index never sees two primaries.
+func (communicationStore *via) SetPrimary(ctx context.Context, command *model.SetPrimaryViaCommand) (*model.SetPrimaryViaResult, error) {
+	const (
+		demoteStmt = `
+			update "im_contact"."via" v
+			set "is_primary" = false, "updated_by" = @UpdatedBy
+			from "im_contact"."via" t
+			where (t."contact_id", t."via") = (@ContactID, @Via)
+			and v."contact_id" = t."contact_id"
+			and v."kind" = t."kind"
+			and v."via" <> t."via"
+			and v."is_primary"
+			returning ` + viaReturningColumns
+
+		promoteStmt = `
+			update "im_contact"."via" v
+			set "is_primary" = true, "updated_by" = @UpdatedBy
+			where (v."contact_id", v."via") = (@ContactID, @Via)
+			and not v."is_primary"
+			returning ` + viaReturningColumns
+
+		selectStmt = `
+			select ` + viaReturningColumns + `
+			from "im_contact"."via" v
+			where (v."contact_id", v."via") = (@ContactID, @Via)`
+	)
+
+	args := pgx.NamedArgs{"ContactID": command.ContactID, "Via": command.Via, "UpdatedBy": command.UpdatedBy}
+	result := new(model.SetPrimaryViaResult)
+
+	err := pgx.BeginFunc(ctx, communicationStore.db.Master(), func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, demoteStmt, args)
+		if err != nil {
+			return err
+		}
+
+		demoted, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+		if err != nil {
+			return err
+		}
+
+		rows, err = tx.Query(ctx, promoteStmt, args)
+		if err != nil {
+			return err
+		}
+
+		promoted, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+		if err != nil {
+			return err
+		}
+
+		if len(promoted) == 1 {
+			result.Primary = promoted[0]
+			result.Changed = append(promoted, demoted...)
+
+			return nil
+		}
+
+		// already primary, nothing changed
+		rows, err = tx.Query(ctx, selectStmt, args)
+		if err != nil {
+			return err
+		}
+
+		result.Primary, err = pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(
+				"via doesn`t exist",
+				errors.WithCause(err),
+				errors.WithID("postgres.communication.set_primary"),
+				errors.WithValue("contact_id", command.ContactID.String()),
+				errors.WithValue("via", command.Via),
+			)
+		}
+
+		if ok, rerr := pg.ErrorIntegrityViolation(err); ok {
+			return nil, errors.Wrap(rerr, errors.WithID("postgres.communication.set_primary"))
+		}
+
+		return nil, errors.Internal("setting primary via", errors.WithCause(err), errors.WithID("postgres.communication.set_primary"))
+	}
+
+	return result, nil
+}
+
+// RegisterDeliveryFailure counts one failed delivery and disables the via
+// once the counter reaches the threshold. A zero threshold never disables.
+// The change is made by the system, so updated_by is reset to 0.
+func (communicationStore *via) RegisterDeliveryFailure(
+	ctx context.Context,
+	command *model.RegisterDeliveryFailureCommand,
+	policy model.DeliveryFailurePolicy,
+) (*model.DeliveryFailureResult, error) {
+	const stmt = `
+		with prev as (
+			select "contact_id", "via", "disable", "failure_count" + 1 as "failure_count",
+				@Threshold::int > 0 and not "disable" and "failure_count" + 1 >= @Threshold::int as "crossed"
+			from "im_contact"."via"
+			where ("contact_id", "via") = (@ContactID, @Via)
+			for update
+		)
+		update "im_contact"."via" v
+		set
+			"failure_count" = p."failure_count",
+			"last_failure_at" = greatest(v."last_failure_at", @FailedAt::timestamptz),
+			"disable" = v."disable" or p."crossed",
+			"disable_reason" = case when p."crossed" then @Reason else v."disable_reason" end,
+			"updated_by" = 0
+		from prev p
+		where (v."contact_id", v."via") = (p."contact_id", p."via")
+		returning ` + viaReturningColumns + `, p."crossed" as "auto_disabled"`
+
+	args := pgx.NamedArgs{
+		"ContactID": command.ContactID,
+		"Via":       command.Via,
+		"FailedAt":  command.FailedAt,
+		"Threshold": policy.DisableThreshold,
+		"Reason":    model.DisableReasonDeliveryFailures,
+	}
+
+	rows, err := communicationStore.db.Master().Query(ctx, stmt, args)
+	if err != nil {
+		return nil, errors.Internal("executing register delivery failure stmt", errors.WithCause(err), errors.WithID("postgres.communication.register_delivery_failure"))
+	}
+
+	type failedVia struct {
+		model.ViaCommunication
+
+		AutoDisabled bool `db:"auto_disabled"`
+	}
+
+	failed, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[failedVia])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(
+				"via doesn`t exist",
+				errors.WithCause(err),
+				errors.WithID("postgres.communication.register_delivery_failure"),
+				errors.WithValue("contact_id", command.ContactID.String()),
+				errors.WithValue("via", command.Via),
+			)
+		}
+
+		return nil, errors.Internal("collecting failed communication row", errors.WithCause(err), errors.WithID("postgres.communication.register_delivery_failure"))
+	}
+
+	return &model.DeliveryFailureResult{Via: &failed.ViaCommunication, Disabled: failed.AutoDisabled}, nil
+}
+
+// ReenableExpired enables up to limit vias whose suspension ended by now, as the system (updated_by 0).
+// Locked rows are skipped, so concurrent instances pick different batches.
+func (communicationStore *via) ReenableExpired(ctx context.Context, now time.Time, limit int) ([]*model.ViaCommunication, error) {
+	const stmt = `
+		with expired as (
+			select "contact_id", "via"
+			from "im_contact"."via"
+			where "disabled_until" <= @Now
+			order by "disabled_until"
+			limit @Limit
+			for update skip locked
+		)
+		update "im_contact"."via" v
+		set
+			"disable" = false,
+			"disable_reason" = null,
+			"disabled_until" = null,
+			"failure_count" = 0,
+			"updated_by" = 0
+		from expired e
+		where (v."contact_id", v."via") = (e."contact_id", e."via")
+		returning ` + viaReturningColumns
+
+	rows, err := communicationStore.db.Master().Query(ctx, stmt, pgx.NamedArgs{"Now": now, "Limit": limit})
+	if err != nil {
+		return nil, errors.Internal("executing reenable expired vias stmt", errors.WithCause(err), errors.WithID("postgres.communication.reenable_expired"))
+	}
+
+	reenabled, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+	if err != nil {
+		return nil, errors.Internal("collecting reenabled communication rows", errors.WithCause(err), errors.WithID("postgres.communication.reenable_expired"))
+	}
+
+	return reenabled, nil
+}
+
+// Replace makes the contact vias match the desired set in one transaction.
+// The contact row is locked first, so concurrent replaces and creates for the contact wait for each other.
+func (communicationStore *via) Replace(ctx context.Context, command *model.ReplaceViasCommand) (*model.ReplaceViasResult, error) {
+	const (
+		lockContactStmt = `select "id" from "im_contact"."contact" where "id" = @ContactID for update`
+
+		selectStmt = `
+			select ` + viaReturningColumns + `
+			from "im_contact"."via" v
+			where v."contact_id" = @ContactID
+			order by v."is_primary" desc, v."priority", v."created_at"`
+
+		deleteStmt = `
+			delete from "im_contact"."via" v
+			where v."contact_id" = @ContactID and v."via" = any(@Vias)
+			returning ` + viaReturningColumns
+	)
+
+	contactArgs := pgx.NamedArgs{"ContactID": command.ContactID}
+	result := new(model.ReplaceViasResult)
+
+	err := pgx.BeginFunc(ctx, communicationStore.db.Master(), func(tx pgx.Tx) error {
+		var id uuid.UUID
+		if err := tx.QueryRow(ctx, lockContactStmt, contactArgs).Scan(&id); err != nil {
+			return err
+		}
+
+		rows, err := tx.Query(ctx, selectStmt, contactArgs)
+		if err != nil {
+			return err
+		}
+
+		existing, err := pgx.CollectRows(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+		if err != nil {
+			return err
+		}
+
+		plan, err := model.PlanViaReplace(existing, command.Items)
+		if err != nil {
+			return err
+		}
+
+		if plan.Empty() {
+			result.Vias = existing
+
+			return nil
+		}
+
+		if len(plan.Delete) > 0 {
+			rows, err = tx.Query(ctx, deleteStmt, pgx.NamedArgs{"ContactID": command.ContactID, "Vias": plan.Delete})
+			if err != nil {
+				return err
+			}
+
+			if result.Deleted, err = pgx.CollectRows(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication]); err != nil {
+				return err
+			}
+		}
+
+		for _, item := range plan.Update {
+			stmt, args := communicationStore.prepareUpdateStmt(&model.ViaCommunication{
+				ContactID:     command.ContactID,
+				Via:           item.Via,
+				Disable:       item.Disable,
+				DisableReason: item.DisableReason,
+				Metadata:      item.Metadata,
+				Kind:          item.Kind,
+				Priority:      item.Priority,
+				DisabledUntil: item.DisabledUntil,
+				UpdatedBy:     command.UpdatedBy,
+			})
+
+			rows, err = tx.Query(ctx, stmt, args)
+			if err != nil {
+				return err
+			}
+
+			updated, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+			if err != nil {
+				return err
+			}
+
+			result.Updated = append(result.Updated, updated)
+		}
+
+		// like single updates and deletes, a kind that lost its primary via hands the flag to the next one
+		for _, kind := range lostPrimary(existing, plan) {
+			promoted, err := promotePrimary(ctx, tx, command.ContactID, kind, command.UpdatedBy)
+			if err != nil {
+				return err
+			}
+
+			if promoted == nil {
+				continue
+			}
+
+			if i := slices.IndexFunc(result.Updated, func(v *model.ViaCommunication) bool { return v.Via == promoted.Via }); i >= 0 {
+				result.Updated[i] = promoted
+			} else {
+				result.Updated = append(result.Updated, promoted)
+			}
+		}
+
+		for _, item := range plan.Create {
+			stmt, args := communicationStore.prepareCreateStmt(&model.CreateViaCommunicationCommand{
+				ContactID:     command.ContactID,
+				Via:           item.Via,
+				Disable:       item.Disable,
+				DisableReason: item.DisableReason,
+				Metadata:      item.Metadata,
+				Kind:          item.Kind,
+				Priority:      item.Priority,
+				DisabledUntil: item.DisabledUntil,
+				CreatedBy:     command.UpdatedBy,
+			})
+
+			rows, err = tx.Query(ctx, stmt, args)
+			if err != nil {
+				return err
+			}
+
+			created, err := pgx.CollectOneRow(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+			if err != nil {
+				return err
+			}
+
+			result.Created = append(result.Created, created)
+		}
+
+		rows, err = tx.Query(ctx, selectStmt, contactArgs)
+		if err != nil {
+			return err
+		}
+
+		result.Vias, err = pgx.CollectRows(rows, pgx.RowToAddrOfStructByNameLax[model.ViaCommunication])
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, errors.NotFound(
+				"contact doesn`t exist",
+				errors.WithCause(err),
+				errors.WithID("postgres.communication.replace"),
+				errors.WithValue("contact_id", command.ContactID.String()),
+			)
+		}
+
+		// the items don't apply to the stored vias
+		if errors.Code(err) == codes.InvalidArgument {
+			return nil, err
+		}
+
+		if ok, rerr := pg.ErrorIntegrityViolation(err); ok {
+			return nil, errors.Wrap(rerr, errors.WithID("postgres.communication.replace"), errors.WithValue("contact_id", command.ContactID.String()))
+		}
+
+		return nil, errors.Internal("replacing contact vias", errors.WithCause(err), errors.WithID("postgres.communication.replace"))
+	}
+
+	return result, nil
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/infra/db/pg"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+var _ store.ViaConsentStore = (*viaConsentStore)(nil)
+
+type viaConsentStore struct {
+	db *pg.PgxDB
+}
+
+func NewViaConsentStore(db *pg.PgxDB) *viaConsentStore {
+	return &viaConsentStore{db: db}
+}
+
+// Record implements [store.ViaConsentStore].
+func (s *viaConsentStore) Record(ctx context.Context, command *model.ChangeViaConsentCommand) (*model.ViaConsent, error) {
+	var recorded model.ViaConsent
+
+	err := pgxscan.Get(
+		ctx,
+		s.db.Master(),
+		&recorded,
+		`INSERT INTO im_contact.via_consent(contact_id, via, status, source, legal_basis, occurred_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 RETURNING id, contact_id, via, status, source, legal_basis, occurred_at, created_at`,
+		command.ContactID,
+		command.Via,
+		command.Status,
+		command.Source,
+		command.LegalBasis,
+		command.OccurredAt,
+	)
+	if err != nil {
+		if ok, rerr := pg.ErrorIntegrityViolation(err); ok {
+			return nil, errors.Wrap(rerr, errors.WithID("postgres.via_consent_store.record"))
+		}
+
+		return nil, errors.Internal("inserting via consent", errors.WithCause(err), errors.WithID("postgres.via_consent_store.record"))
+	}
+
+	return &recorded, nil
+}
+
+// Current implements [store.ViaConsentStore].
+func (s *viaConsentStore) Current(ctx context.Context, contactID uuid.UUID, via string) (*model.ViaConsent, error) {
+	var current []*model.ViaConsent
+
+	err := pgxscan.Select(
+		ctx,
+		s.db.Reader(ctx),
+		&current,
+		`SELECT id, contact_id, via, status, source, legal_basis, occurred_at, created_at
+		 FROM im_contact.via_consent
+		 WHERE contact_id = $1 AND via = $2
+		 ORDER BY occurred_at DESC, created_at DESC
+		 LIMIT 1`,
+		contactID,
+		via,
+	)
+	if err != nil {
+		return nil, errors.Internal("selecting current via consent", errors.WithCause(err), errors.WithID("postgres.via_consent_store.current"))
+	}
+
+	if len(current) == 0 {
+		return nil, nil
+	}
+
+	return current[0], nil
+}
+
+// History implements [store.ViaConsentStore]. Changes go newest first.
+func (s *viaConsentStore) History(ctx context.Context, contactID uuid.UUID, via string) ([]*model.ViaConsent, error) {
+	var history []*model.ViaConsent
+
+	err := pgxscan.Select(
+		ctx,
+		s.db.Reader(ctx),
+		&history,
+		`SELECT id, contact_id, via, status, source, legal_basis, occurred_at, created_at
+		 FROM im_contact.via_consent
+		 WHERE contact_id = $1 AND via = $2
+		 ORDER BY occurred_at DESC, created_at DESC`,
+		contactID,
+		via,
+	)
+	if err != nil {
+		return nil, errors.Internal("selecting via consent history", errors.WithCause(err), errors.WithID("postgres.via_consent_store.history"))
+	}
+
+	return history, nil
+}
@@ -0,0 +1,131 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/infra/db/pg"
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+)
+
+var _ store.ViaVerificationStore = (*viaVerificationStore)(nil)
+
+type viaVerificationStore struct {
+	db *pg.PgxDB
+}
+
+func NewViaVerificationStore(db *pg.PgxDB) *viaVerificationStore {
+	return &viaVerificationStore{db: db}
+}
+
+// Save implements [store.ViaVerificationStore]. A new code replaces the pending one and resets its attempts
+// once resendAfter has passed since the pending code was created.
+func (s *viaVerificationStore) Save(ctx context.Context, verification *model.ViaVerification, resendAfter time.Duration) (*model.ViaVerification, error) {
+	var saved model.ViaVerification
+
+	err := pgxscan.Get(
+		ctx,
+		s.db.Master(),
+		&saved,
+		`INSERT INTO im_contact.via_verification(contact_id, via, code_hash, salt, max_attempts, expires_at)
+		 VALUES ($1, $2, $3, $4, $5, $6)
+		 ON CONFLICT (contact_id, via) DO UPDATE
+		 SET code_hash = EXCLUDED.code_hash,
+		     salt = EXCLUDED.salt,
+		     attempts = 0,
+		     max_attempts = EXCLUDED.max_attempts,
+		     expires_at = EXCLUDED.expires_at,
+		     created_at = now()
+		 WHERE im_contact.via_verification.created_at <= now() - make_interval(secs => $7)
+		 RETURNING contact_id, via, code_hash, salt, attempts, max_attempts, expires_at, created_at`,
+		verification.ContactID,
+		verification.Via,
+		verification.CodeHash,
+		verification.Salt,
+		verification.MaxAttempts,
+		verification.ExpiresAt,
+		resendAfter.Seconds(),
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		if ok, rerr := pg.ErrorIntegrityViolation(err); ok {
+			return nil, errors.Wrap(rerr, errors.WithID("postgres.via_verification_store.save"))
+		}
+
+		return nil, errors.Internal("saving via verification", errors.WithCause(err), errors.WithID("postgres.via_verification_store.save"))
+	}
+
+	return &saved, nil
+}
+
+// Get implements [store.ViaVerificationStore]. It always reads master, a replica may not have the fresh code yet.
+func (s *viaVerificationStore) Get(ctx context.Context, contactID uuid.UUID, via string) (*model.ViaVerification, error) {
+	var verification model.ViaVerification
+
+	err := pgxscan.Get(
+		ctx,
+		s.db.Master(),
+		&verification,
+		`SELECT contact_id, via, code_hash, salt, attempts, max_attempts, expires_at, created_at
+		 FROM im_contact.via_verification
+		 WHERE contact_id = $1 AND via = $2`,
+		contactID,
+		via,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, errors.Internal("selecting via verification", errors.WithCause(err), errors.WithID("postgres.via_verification_store.get"))
+	}
+
+	return &verification, nil
+}
+
+// ReserveAttempt implements [store.ViaVerificationStore]. The attempt is spent before the code is compared,
+// so concurrent guesses cannot get past max_attempts.
+func (s *viaVerificationStore) ReserveAttempt(ctx context.Context, contactID uuid.UUID, via string) (*model.ViaVerification, error) {
+	var verification model.ViaVerification
+
+	err := pgxscan.Get(
+		ctx,
+		s.db.Master(),
+		&verification,
+		`UPDATE im_contact.via_verification
+		 SET attempts = attempts + 1
+		 WHERE contact_id = $1 AND via = $2 AND attempts < max_attempts AND expires_at > now()
+		 RETURNING contact_id, via, code_hash, salt, attempts, max_attempts, expires_at, created_at`,
+		contactID,
+		via,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, errors.Internal("reserving verification attempt", errors.WithCause(err), errors.WithID("postgres.via_verification_store.reserve_attempt"))
+	}
+
+	return &verification, nil
+}
+
+// Delete implements [store.ViaVerificationStore].
+func (s *viaVerificationStore) Delete(ctx context.Context, contactID uuid.UUID, via string) error {
+	_, err := s.db.Master().Exec(ctx, `DELETE FROM im_contact.via_verification WHERE contact_id = $1 AND via = $2`, contactID, via)
+	if err != nil {
+		return errors.Internal("deleting via verification", errors.WithCause(err), errors.WithID("postgres.via_verification_store.delete"))
+	}
+
+	return nil
+}
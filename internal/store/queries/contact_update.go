@@ -59,6 +59,12 @@ func (q *ContactUpdateQuery) WithSubject(sub string) *ContactUpdateQuery {
 	return q
 }
 
+func (q *ContactUpdateQuery) WithUpdatedBy(userID int) *ContactUpdateQuery {
+	q.builder = q.builder.Set("updated_by", userID)
+
+	return q
+}
+
 func (q *ContactUpdateQuery) ToSQL() (string, []any, error) {
 	return q.builder.Suffix("RETURNING *").ToSql()
 }
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -14,21 +15,71 @@ type ContactStore interface {
 	Create(ctx context.Context, contact *model.Contact) (*model.Contact, error)
 	Update(ctx context.Context, updater *model.UpdateContactRequest) (*model.Contact, error)
 	Delete(ctx context.Context, command *model.DeleteContactRequest) error
-	ClearByDomain(ctx context.Context, domainID int) error
+	ClearByDomain(ctx context.Context, domainID int) ([]uuid.UUID, error)
 	Upsert(ctx context.Context, contact *model.Contact) (*model.Contact, bool, error)
 	PartialUpdate(ctx context.Context, query queries.Query) (*model.Contact, error)
-	DeleteBotByFlowID(ctx context.Context, flowID string) error
+	DeleteBotByFlowID(ctx context.Context, flowID string) ([]uuid.UUID, error)
 	Locate(ctx context.Context, locate *model.LocateContactRequest) (*model.Contact, error)
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*model.Contact, error)
+	ResolveByVia(ctx context.Context, request *model.ResolveByViaRequest) ([]*model.ResolvedContact, error)
 }
 type SettingsStore interface {
 	Get(ctx context.Context, contactID uuid.UUID) (*model.ContactSettings, error)
-	Update(ctx context.Context, command *model.UpdateContactSettingsRequest) (*model.ContactSettings, error)
+	GetMany(ctx context.Context, contactIDs []uuid.UUID) ([]*model.ContactSettings, error)
+	// Update returns the settings as they were right before the update and after it.
+	Update(ctx context.Context, command *model.UpdateContactSettingsRequest) (old, updated *model.ContactSettings, err error)
 	Create(ctx context.Context, command *model.CreateContactSettingsRequest) (*model.ContactSettings, error)
 }
 
+// SettingsTemplateStore keeps per-domain defaults for contact settings.
+// Get returns nil when the domain has no template.
+type SettingsTemplateStore interface {
+	Get(ctx context.Context, domainID int) (*model.SettingsTemplate, error)
+	// Upsert also sets the locked fields of the domain contacts settings to the template values
+	// and returns the settings it changed, before and after the change.
+	Upsert(ctx context.Context, template *model.SettingsTemplate) (saved *model.SettingsTemplate, enforced []*model.SettingsChange, err error)
+	Delete(ctx context.Context, domainID int) error
+}
+
+type PrivacyRuleStore interface {
+	Create(ctx context.Context, rule *model.PrivacyRule) (*model.PrivacyRule, error)
+	List(ctx context.Context, domainID int) ([]*model.PrivacyRule, error)
+	Delete(ctx context.Context, domainID int, id uuid.UUID) error
+}
+
 type ViaStore interface {
 	Create(ctx context.Context, communication *model.CreateViaCommunicationCommand) (*model.ViaCommunication, error)
-	Update(ctx context.Context, communication *model.ViaCommunication) (*model.ViaCommunication, error)
-	PartialUpdate(ctx context.Context, updateCommand *model.CommunicationViaPartialUpdateCmd) (*model.ViaCommunication, error)
+	// Update, PartialUpdate and Delete hand the primary flag of a via that leaves its kind,
+	// by a kind change or by deletion, over to the next via of that kind.
+	Update(ctx context.Context, communication *model.ViaCommunication) (*model.ViaChangeResult, error)
+	PartialUpdate(ctx context.Context, updateCommand *model.CommunicationViaPartialUpdateCmd) (*model.ViaChangeResult, error)
 	Search(ctx context.Context, filter *model.SearchViaCommunicationsFilter) ([]*model.ViaCommunication, error)
+	Delete(ctx context.Context, deleteCommand *model.DeleteViaCommand) (*model.ViaChangeResult, error)
+	DeleteByContact(ctx context.Context, contactID uuid.UUID) ([]*model.ViaCommunication, error)
+	MarkVerified(ctx context.Context, contactID uuid.UUID, via string, updatedBy int) (*model.ViaCommunication, error)
+	SetPrimary(ctx context.Context, command *model.SetPrimaryViaCommand) (*model.SetPrimaryViaResult, error)
+	RegisterDeliveryFailure(ctx context.Context, command *model.RegisterDeliveryFailureCommand, policy model.DeliveryFailurePolicy) (*model.DeliveryFailureResult, error)
+	ReenableExpired(ctx context.Context, now time.Time, limit int) ([]*model.ViaCommunication, error)
+	Replace(ctx context.Context, command *model.ReplaceViasCommand) (*model.ReplaceViasResult, error)
+}
+
+// ViaConsentStore keeps the marketing consent history of vias.
+// Current returns nil when no consent was ever recorded for the via.
+type ViaConsentStore interface {
+	Record(ctx context.Context, command *model.ChangeViaConsentCommand) (*model.ViaConsent, error)
+	Current(ctx context.Context, contactID uuid.UUID, via string) (*model.ViaConsent, error)
+	History(ctx context.Context, contactID uuid.UUID, via string) ([]*model.ViaConsent, error)
+}
+
+// ViaVerificationStore keeps pending verification codes, one per via.
+// Get returns nil when no verification is pending.
+type ViaVerificationStore interface {
+	// Save replaces the pending verification unless it was created less than resendAfter ago,
+	// in which case it returns nil.
+	Save(ctx context.Context, verification *model.ViaVerification, resendAfter time.Duration) (*model.ViaVerification, error)
+	Get(ctx context.Context, contactID uuid.UUID, via string) (*model.ViaVerification, error)
+	// ReserveAttempt spends an attempt of a pending verification that is neither expired nor exhausted
+	// and returns it with the attempt counted, or nil when there is no such verification.
+	ReserveAttempt(ctx context.Context, contactID uuid.UUID, via string) (*model.ViaVerification, error)
+	Delete(ctx context.Context, contactID uuid.UUID, via string) error
 }
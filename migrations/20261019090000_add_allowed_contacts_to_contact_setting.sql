@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE im_contact.contact_setting ADD COLUMN allowed_contacts uuid[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE im_contact.contact_setting DROP COLUMN allowed_contacts;
+-- +goose StatementEnd
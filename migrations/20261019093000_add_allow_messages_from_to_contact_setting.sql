@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE im_contact.contact_setting ADD COLUMN allow_messages_from int NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE im_contact.contact_setting DROP COLUMN allow_messages_from;
+-- +goose StatementEnd
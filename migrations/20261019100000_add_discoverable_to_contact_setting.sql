@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE im_contact.contact_setting ADD COLUMN discoverable BOOLEAN NOT NULL DEFAULT TRUE;
+
+CREATE INDEX contact_setting_hidden_idx ON im_contact.contact_setting (contact_id) WHERE NOT discoverable;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS im_contact.contact_setting_hidden_idx;
+
+ALTER TABLE im_contact.contact_setting DROP COLUMN discoverable;
+-- +goose StatementEnd
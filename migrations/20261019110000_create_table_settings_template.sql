@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE im_contact.settings_template (
+    "domain_id" bigint primary key,
+    "allow_invites_from" int default 0 not null,
+    "allow_messages_from" int default 0 not null,
+    "discoverable" boolean default true not null,
+    "locked_fields" text[] default '{}' not null,
+    "updated_at" timestamptz default now() not null
+);
+
+
+CREATE OR REPLACE FUNCTION im_contact.create_setting_on_insert() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO im_contact.contact_setting ("contact_id", "allow_invites_from", "allow_messages_from", "discoverable")
+    SELECT NEW.id,
+           coalesce(t.allow_invites_from, 0),
+           coalesce(t.allow_messages_from, 0),
+           coalesce(t.discoverable, true)
+    FROM (SELECT NEW.domain_id AS domain_id) c
+    LEFT JOIN im_contact.settings_template t ON t.domain_id = c.domain_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION im_contact.create_setting_on_insert() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO im_contact.contact_setting ("contact_id") VALUES (NEW.id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS im_contact.settings_template;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE im_contact.privacy_rule (
+    "id" uuid default uuidv7() primary key,
+    "domain_id" bigint not null,
+    "name" text not null,
+    "expression" text not null,
+    "scope" int default 0 not null,
+    "created_at" timestamptz default now() not null,
+    CONSTRAINT "privacy_rule_domain_name_unique" UNIQUE ("domain_id", "name")
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS im_contact.privacy_rule;
+-- +goose StatementEnd
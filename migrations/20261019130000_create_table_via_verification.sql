@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE im_contact.via ADD COLUMN IF NOT EXISTS verified_at timestamptz;
+
+CREATE INDEX IF NOT EXISTS via_verified_idx ON im_contact.via (contact_id) WHERE verified_at IS NOT NULL;
+
+CREATE TABLE im_contact.via_verification (
+    "contact_id" uuid not null,
+    "via" text not null,
+    "code_hash" bytea not null,
+    "salt" bytea not null,
+    "attempts" int default 0 not null,
+    "max_attempts" int not null,
+    "expires_at" timestamptz not null,
+    "created_at" timestamptz default now() not null,
+    PRIMARY KEY ("contact_id", "via"),
+    FOREIGN KEY ("contact_id", "via") REFERENCES im_contact.via ("contact_id", "via") ON DELETE CASCADE
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS im_contact.via_verification;
+DROP INDEX IF EXISTS im_contact.via_verified_idx;
+ALTER TABLE im_contact.via DROP COLUMN IF EXISTS verified_at;
+-- +goose StatementEnd
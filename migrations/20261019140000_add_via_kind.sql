@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+-- the key stays ("contact_id", "via"): a value has a single kind per contact, so a number
+-- normalized to E.164 can't be registered as both a phone and a whatsapp via of one contact
+ALTER TABLE im_contact.via
+    ADD COLUMN IF NOT EXISTS kind text default 'custom' not null
+        CONSTRAINT via_kind_check CHECK (kind IN ('phone', 'email', 'telegram', 'whatsapp', 'custom'));
+
+-- backfill renames vias, so pending codes have to follow their via
+ALTER TABLE im_contact.via_verification
+    DROP CONSTRAINT IF EXISTS via_verification_contact_id_via_fkey,
+    ADD CONSTRAINT via_verification_contact_id_via_fkey FOREIGN KEY ("contact_id", "via")
+        REFERENCES im_contact.via ("contact_id", "via") ON DELETE CASCADE ON UPDATE CASCADE;
+
+-- vias the normalization backfill could not rewrite because the normalized value is already taken
+CREATE TABLE im_contact.via_normalization_conflict (
+    "contact_id" uuid not null,
+    "via" text not null,
+    "kind" text not null,
+    "normalized" text not null,
+    "created_at" timestamptz default now() not null,
+    PRIMARY KEY ("contact_id", "via")
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS im_contact.via_normalization_conflict;
+
+ALTER TABLE im_contact.via_verification
+    DROP CONSTRAINT IF EXISTS via_verification_contact_id_via_fkey,
+    ADD CONSTRAINT via_verification_contact_id_via_fkey FOREIGN KEY ("contact_id", "via")
+        REFERENCES im_contact.via ("contact_id", "via") ON DELETE CASCADE;
+
+ALTER TABLE im_contact.via DROP COLUMN IF EXISTS kind;
+-- +goose StatementEnd
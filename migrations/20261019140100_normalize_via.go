@@ -0,0 +1,212 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"log/slog"
+	"strings"
+	"unicode"
+
+	"github.com/pressly/goose/v3"
+)
+
+func init() {
+	goose.AddMigrationContext(upNormalizeVia, downNormalizeVia)
+}
+
+// normalizeViaBatch is the number of vias read per query, so the table is never loaded at once.
+const normalizeViaBatch = 1000
+
+// upNormalizeVia sets the kind of existing vias that are clearly phones or emails and rewrites them
+// to their normalized form. Everything else, e.g. numeric ids of other systems, keeps its value and
+// the default custom kind.
+// A via whose normalized value is already taken by another via of the same contact is left untouched
+// and reported in im_contact.via_normalization_conflict for a manual merge.
+//
+// The classification is a copy of the rules at the time of the migration: it must not change
+// with the live normalizers.
+func upNormalizeVia(ctx context.Context, tx *sql.Tx) error {
+	var (
+		lastContactID string
+		lastVia       string
+		conflicts     int
+	)
+
+	for {
+		batch, err := selectViaBatch(ctx, tx, lastContactID, lastVia)
+		if err != nil {
+			return err
+		}
+
+		for _, key := range batch {
+			kind, normalized, ok := legacyViaKind(key.via)
+			if !ok {
+				continue
+			}
+
+			renamed, err := tx.ExecContext(
+				ctx,
+				`UPDATE im_contact.via v SET via = $3, kind = $4
+				 WHERE v.contact_id = $1 AND v.via = $2
+				   AND ($2 = $3 OR NOT EXISTS (SELECT 1 FROM im_contact.via t WHERE t.contact_id = $1 AND t.via = $3))`,
+				key.contactID, key.via, normalized, kind,
+			)
+			if err != nil {
+				return err
+			}
+
+			n, err := renamed.RowsAffected()
+			if err != nil {
+				return err
+			}
+
+			if n > 0 {
+				continue
+			}
+
+			conflicts++
+
+			if _, err := tx.ExecContext(
+				ctx,
+				`INSERT INTO im_contact.via_normalization_conflict(contact_id, via, kind, normalized)
+				 VALUES ($1, $2, $3, $4)
+				 ON CONFLICT (contact_id, via) DO UPDATE SET kind = EXCLUDED.kind, normalized = EXCLUDED.normalized`,
+				key.contactID, key.via, kind, normalized,
+			); err != nil {
+				return err
+			}
+		}
+
+		if len(batch) < normalizeViaBatch {
+			break
+		}
+
+		last := batch[len(batch)-1]
+		lastContactID, lastVia = last.contactID, last.via
+	}
+
+	if conflicts > 0 {
+		slog.WarnContext(ctx, "via normalization left colliding vias untouched, see im_contact.via_normalization_conflict", "conflicts", conflicts)
+	}
+
+	return nil
+}
+
+type viaKey struct {
+	contactID string
+	via       string
+}
+
+// selectViaBatch reads the vias following the given key in key order, from the start when afterContactID is empty.
+func selectViaBatch(ctx context.Context, tx *sql.Tx, afterContactID, afterVia string) ([]viaKey, error) {
+	var (
+		rows *sql.Rows
+		err  error
+	)
+
+	if afterContactID == "" {
+		rows, err = tx.QueryContext(
+			ctx,
+			`SELECT contact_id::text, via FROM im_contact.via ORDER BY contact_id, via LIMIT $1`,
+			normalizeViaBatch,
+		)
+	} else {
+		rows, err = tx.QueryContext(
+			ctx,
+			`SELECT contact_id::text, via FROM im_contact.via
+			 WHERE (contact_id, via) > ($1::uuid, $2)
+			 ORDER BY contact_id, via
+			 LIMIT $3`,
+			afterContactID, afterVia, normalizeViaBatch,
+		)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	batch := make([]viaKey, 0, normalizeViaBatch)
+
+	for rows.Next() {
+		var key viaKey
+		if err := rows.Scan(&key.contactID, &key.via); err != nil {
+			return nil, err
+		}
+
+		batch = append(batch, key)
+	}
+
+	return batch, rows.Err()
+}
+
+// legacyViaKind reports the kind and normalized form of a via that is clearly a phone or an email.
+// A phone has to be written with its "+" country code prefix: bare numbers may be ids of other systems.
+func legacyViaKind(value string) (kind, normalized string, ok bool) {
+	value = strings.TrimSpace(value)
+
+	if phone, ok := legacyPhone(value); ok {
+		return "phone", phone, true
+	}
+
+	if email, ok := legacyEmail(value); ok {
+		return "email", email, true
+	}
+
+	return "", "", false
+}
+
+func legacyPhone(value string) (string, bool) {
+	if !strings.HasPrefix(value, "+") {
+		return "", false
+	}
+
+	var digits strings.Builder
+
+	for _, r := range value[1:] {
+		switch {
+		case r >= '0' && r <= '9':
+			digits.WriteRune(r)
+		case r == ' ' || r == '-' || r == '(' || r == ')' || r == '.':
+		default:
+			return "", false
+		}
+	}
+
+	d := digits.String()
+	if len(d) < 8 || len(d) > 15 || d[0] == '0' {
+		return "", false
+	}
+
+	return "+" + d, true
+}
+
+// legacyEmail accepts ASCII addresses with a dotted domain only; anything else stays custom.
+func legacyEmail(value string) (string, bool) {
+	v := strings.ToLower(value)
+
+	at := strings.LastIndexByte(v, '@')
+	if at <= 0 || at == len(v)-1 || strings.Count(v, "@") != 1 {
+		return "", false
+	}
+
+	domain := strings.TrimSuffix(v[at+1:], ".")
+	if !strings.Contains(domain, ".") || strings.HasPrefix(domain, ".") {
+		return "", false
+	}
+
+	for _, r := range v {
+		if r > unicode.MaxASCII || unicode.IsSpace(r) || unicode.IsControl(r) {
+			return "", false
+		}
+	}
+
+	return v[:at] + "@" + domain, true
+}
+
+// downNormalizeVia keeps the normalized values: the original spelling is not recoverable.
+func downNormalizeVia(ctx context.Context, tx *sql.Tx) error {
+	_, err := tx.ExecContext(ctx, `UPDATE im_contact.via SET kind = 'custom'`)
+
+	return err
+}
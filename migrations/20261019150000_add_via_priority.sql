@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE im_contact.via
+    ADD COLUMN IF NOT EXISTS is_primary boolean default false not null,
+    ADD COLUMN IF NOT EXISTS priority int default 0 not null;
+
+-- one primary via per contact and kind
+CREATE UNIQUE INDEX IF NOT EXISTS via_primary_kind_uidx ON im_contact.via (contact_id, kind) WHERE is_primary;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS im_contact.via_primary_kind_uidx;
+
+ALTER TABLE im_contact.via
+    DROP COLUMN IF EXISTS is_primary,
+    DROP COLUMN IF EXISTS priority;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- reverse lookup of the owning contact by a normalized via
+CREATE INDEX IF NOT EXISTS via_lookup_idx ON im_contact.via (via, kind) INCLUDE (contact_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS im_contact.via_lookup_idx;
+-- +goose StatementEnd
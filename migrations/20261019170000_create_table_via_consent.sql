@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+-- every consent change is kept, the latest one by occurred_at is the current consent of the via.
+-- Rows are linked to the via by (contact_id, via) without a foreign key: the history is evidence of consent
+-- and has to outlive the via, e.g. when it is deleted or dropped by a replace.
+CREATE TABLE im_contact.via_consent (
+    "id" uuid default uuidv7() primary key,
+    "contact_id" uuid not null,
+    "via" text not null,
+    "status" text not null CONSTRAINT via_consent_status_check CHECK (status IN ('granted', 'revoked')),
+    "source" text not null,
+    "legal_basis" text not null,
+    "occurred_at" timestamptz default now() not null,
+    "created_at" timestamptz default now() not null
+);
+
+CREATE INDEX IF NOT EXISTS via_consent_latest_idx ON im_contact.via_consent (contact_id, via, occurred_at desc, created_at desc);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS im_contact.via_consent;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE im_contact.via
+    ADD COLUMN IF NOT EXISTS failure_count int default 0 not null,
+    ADD COLUMN IF NOT EXISTS last_failure_at timestamptz;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE im_contact.via
+    DROP COLUMN IF EXISTS failure_count,
+    DROP COLUMN IF EXISTS last_failure_at;
+-- +goose StatementEnd
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE im_contact.via
+    ADD COLUMN IF NOT EXISTS disabled_until timestamptz,
+    ADD CONSTRAINT via_disabled_until_check CHECK (disabled_until IS NULL OR disable);
+
+-- suspensions due for re-enabling
+CREATE INDEX IF NOT EXISTS via_disabled_until_idx ON im_contact.via (disabled_until) WHERE disabled_until IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS im_contact.via_disabled_until_idx;
+
+ALTER TABLE im_contact.via
+    DROP CONSTRAINT IF EXISTS via_disabled_until_check,
+    DROP COLUMN IF EXISTS disabled_until;
+-- +goose StatementEnd
@@ -0,0 +1,85 @@
+-- +goose Up
+-- +goose StatementBegin
+-- 0 marks rows changed by a service rather than a user
+ALTER TABLE im_contact.contact
+    ADD COLUMN IF NOT EXISTS created_by bigint default 0 not null,
+    ADD COLUMN IF NOT EXISTS updated_by bigint default 0 not null;
+
+ALTER TABLE im_contact.via
+    ADD COLUMN IF NOT EXISTS created_by bigint default 0 not null,
+    ADD COLUMN IF NOT EXISTS updated_by bigint default 0 not null;
+
+ALTER TABLE im_contact.contact_setting
+    ADD COLUMN IF NOT EXISTS created_by bigint default 0 not null,
+    ADD COLUMN IF NOT EXISTS updated_by bigint default 0 not null;
+
+-- searching contacts by creator
+CREATE INDEX IF NOT EXISTS contact_created_by_idx ON im_contact.contact (domain_id, created_by);
+
+create or replace function "im_contact"."tg_via_integrity"()
+returns trigger as $$
+begin
+  new.updated_at = now();
+  new.created_at = old.created_at;
+  new.created_by = old.created_by;
+  return new;
+end;
+$$ language 'plpgsql';
+
+-- settings of a new contact are created by whoever created the contact
+CREATE OR REPLACE FUNCTION im_contact.create_setting_on_insert() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO im_contact.contact_setting (
+        "contact_id", "allow_invites_from", "allow_messages_from", "discoverable", "created_by", "updated_by"
+    )
+    SELECT NEW.id,
+           coalesce(t.allow_invites_from, 0),
+           coalesce(t.allow_messages_from, 0),
+           coalesce(t.discoverable, true),
+           NEW.created_by,
+           NEW.created_by
+    FROM (SELECT NEW.domain_id AS domain_id) c
+    LEFT JOIN im_contact.settings_template t ON t.domain_id = c.domain_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION im_contact.create_setting_on_insert() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO im_contact.contact_setting ("contact_id", "allow_invites_from", "allow_messages_from", "discoverable")
+    SELECT NEW.id,
+           coalesce(t.allow_invites_from, 0),
+           coalesce(t.allow_messages_from, 0),
+           coalesce(t.discoverable, true)
+    FROM (SELECT NEW.domain_id AS domain_id) c
+    LEFT JOIN im_contact.settings_template t ON t.domain_id = c.domain_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+create or replace function "im_contact"."tg_via_integrity"()
+returns trigger as $$
+begin
+  new.updated_at = now();
+  new.created_at = old.created_at;
+  return new;
+end;
+$$ language 'plpgsql';
+
+DROP INDEX IF EXISTS im_contact.contact_created_by_idx;
+
+ALTER TABLE im_contact.contact_setting
+    DROP COLUMN IF EXISTS created_by,
+    DROP COLUMN IF EXISTS updated_by;
+
+ALTER TABLE im_contact.via
+    DROP COLUMN IF EXISTS created_by,
+    DROP COLUMN IF EXISTS updated_by;
+
+ALTER TABLE im_contact.contact
+    DROP COLUMN IF EXISTS created_by,
+    DROP COLUMN IF EXISTS updated_by;
+-- +goose StatementEnd
@@ -0,0 +1,29 @@
+package migrations
+
+import "testing"
+
+func TestLegacyViaKind(t *testing.T) {
+	tests := []struct {
+		value      string
+		kind       string
+		normalized string
+	}{
+		{value: "+49 (151) 1234-5678", kind: "phone", normalized: "+4915112345678"},
+		{value: " User@Example.COM ", kind: "email", normalized: "user@example.com"},
+		{value: "12345678901"},
+		{value: "0049151123456"},
+		{value: "@handle"},
+		{value: "user@localhost"},
+		{value: "user@bücher.de"},
+		{value: "+12"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			kind, normalized, ok := legacyViaKind(tt.value)
+			if ok != (tt.kind != "") || kind != tt.kind || normalized != tt.normalized {
+				t.Fatalf("got (%q, %q, %v), want (%q, %q)", kind, normalized, ok, tt.kind, tt.normalized)
+			}
+		})
+	}
+}
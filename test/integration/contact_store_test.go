@@ -0,0 +1,111 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store/postgres"
+)
+
+func TestResolveByViaFindsEveryStoredForm(t *testing.T) {
+	ctx := context.Background()
+
+	contacts := postgres.NewContactStore(db)
+	vias := postgres.NewViaStore(db)
+
+	domainID := newDomain()
+	custom, phone := createContact(t, domainID), createContact(t, domainID)
+
+	createVia(t, vias, custom, "12345678901", model.ViaKindCustom, 0)
+	createVia(t, vias, phone, "+4915112345678", model.ViaKindPhone, 0)
+
+	tests := []struct {
+		name  string
+		kind  model.ViaKind
+		value string
+		want  string
+	}{
+		{name: "raw custom value without a kind", value: "12345678901", want: custom.String()},
+		{name: "custom kind", kind: model.ViaKindCustom, value: " 12345678901", want: custom.String()},
+		{name: "phone written loosely", value: "+49 151 1234 5678", want: phone.String()},
+		{name: "phone kind", kind: model.ViaKindPhone, value: "0049 151 12345678", want: phone.String()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &model.ResolveByViaRequest{DomainID: domainID, Kind: tt.kind, Value: tt.value}
+			if err := request.Normalize(); err != nil {
+				t.Fatalf("normalize: %v", err)
+			}
+
+			resolved, err := contacts.ResolveByVia(ctx, request)
+			if err != nil {
+				t.Fatalf("resolve: %v", err)
+			}
+
+			if len(resolved) != 1 || resolved[0].ID.String() != tt.want {
+				t.Fatalf("resolved %d contacts, want only %s", len(resolved), tt.want)
+			}
+		})
+	}
+}
+
+func TestContactSearchHidesUndiscoverableContacts(t *testing.T) {
+	ctx := context.Background()
+
+	contacts := postgres.NewContactStore(db)
+
+	settings, err := postgres.NewSettingsStore(slog.New(slog.DiscardHandler), db)
+	if err != nil {
+		t.Fatalf("settings store: %v", err)
+	}
+
+	domainID := newDomain()
+	hidden, visible, initiator := createContact(t, domainID), createContact(t, domainID), createContact(t, domainID)
+
+	for contactID, discoverable := range map[uuid.UUID]bool{hidden: false, visible: true} {
+		if _, err := settings.Create(ctx, &model.CreateContactSettingsRequest{
+			ContactID:    contactID,
+			Settings:     &model.ContactSettings{},
+			Discoverable: &discoverable,
+		}); err != nil {
+			t.Fatalf("creating settings: %v", err)
+		}
+	}
+
+	search := func(initiatorID uuid.UUID) []uuid.UUID {
+		t.Helper()
+
+		found, err := contacts.Search(ctx, &model.ContactSearchRequest{DomainID: &domainID, InitiatorContactID: initiatorID, Size: 10})
+		if err != nil {
+			t.Fatalf("searching contacts: %v", err)
+		}
+
+		ids := make([]uuid.UUID, 0, len(found))
+		for _, contact := range found {
+			ids = append(ids, contact.ID)
+		}
+
+		return ids
+	}
+
+	// the initiator has no settings row, which counts as discoverable
+	if got := search(initiator); slices.Contains(got, hidden) || !slices.Contains(got, visible) || !slices.Contains(got, initiator) {
+		t.Fatalf("search by another contact = %v, want %s and %s without %s", got, visible, initiator, hidden)
+	}
+
+	if got := search(hidden); !slices.Contains(got, hidden) {
+		t.Fatalf("search by the undiscoverable contact itself = %v, want it included", got)
+	}
+
+	if got := search(uuid.Nil); !slices.Contains(got, hidden) {
+		t.Fatalf("search without an initiator = %v, want every contact", got)
+	}
+}
@@ -0,0 +1,110 @@
+//go:build integration
+
+// Package integration runs the Postgres stores against a real database started with testcontainers.
+// Run with: go test -tags integration ./test/integration/...
+package integration
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/uuid"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/database"
+
+	"github.com/webitel/im-contact-service/infra/db/pg"
+	"github.com/webitel/im-contact-service/migrations"
+	testhelpers "github.com/webitel/im-contact-service/test/integration/test_helpers"
+)
+
+var (
+	db      *pg.PgxDB
+	domains atomic.Int64
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	ctx := context.Background()
+
+	container, err := testhelpers.NewPostgresContainer(ctx)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "starting postgres:", err)
+
+		return 1
+	}
+
+	defer func() { _ = container.Terminate(ctx) }()
+
+	if err := migrate(ctx, container.ConnectionString); err != nil {
+		fmt.Fprintln(os.Stderr, "migrating:", err)
+
+		return 1
+	}
+
+	db, err = pg.New(ctx, slog.New(slog.DiscardHandler), pg.ConnectionConfig{DSN: container.ConnectionString})
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "connecting:", err)
+
+		return 1
+	}
+
+	defer db.Close()
+
+	return m.Run()
+}
+
+// migrate applies the migrations the same way the migrate command does.
+func migrate(ctx context.Context, dsn string) error {
+	conn, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	store, err := database.NewStore(database.DialectPostgres, "im_contact_schema_version")
+	if err != nil {
+		return err
+	}
+
+	provider, err := goose.NewProvider(goose.Dialect(""), conn, migrations.EmbedMigrations, goose.WithStore(store))
+	if err != nil {
+		return err
+	}
+
+	_, err = provider.Up(ctx)
+
+	return err
+}
+
+// newDomain returns a domain no other test uses, so that templates and rules of tests don't mix.
+func newDomain() int {
+	return int(domains.Add(1))
+}
+
+// createContact inserts a contact of the domain; its settings are created by the insert trigger.
+func createContact(t *testing.T, domainID int) uuid.UUID {
+	t.Helper()
+
+	var id uuid.UUID
+
+	err := db.Master().QueryRow(context.Background(),
+		`INSERT INTO im_contact.contact (domain_id, issuer_id, subject_id, type, username)
+		 VALUES ($1, 'issuer', $2, 'user', $2) RETURNING id`,
+		domainID, uuid.NewString(),
+	).Scan(&id)
+	if err != nil {
+		t.Fatalf("creating contact: %v", err)
+	}
+
+	return id
+}
@@ -0,0 +1,112 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store/postgres"
+)
+
+func TestSettingsCreateDefaultsDiscoverable(t *testing.T) {
+	ctx := context.Background()
+
+	settings, err := postgres.NewSettingsStore(slog.New(slog.DiscardHandler), db)
+	if err != nil {
+		t.Fatalf("settings store: %v", err)
+	}
+
+	create := func(t *testing.T, domainID int, discoverable *bool) *model.ContactSettings {
+		t.Helper()
+
+		contactID := createContact(t, domainID)
+
+		// drop the settings created by the contact trigger to create them explicitly
+		if _, err := db.Master().Exec(ctx, "DELETE FROM im_contact.contact_setting WHERE contact_id = $1", contactID); err != nil {
+			t.Fatalf("deleting trigger settings: %v", err)
+		}
+
+		created, err := settings.Create(ctx, &model.CreateContactSettingsRequest{
+			ContactID:    contactID,
+			Settings:     &model.ContactSettings{},
+			Discoverable: discoverable,
+		})
+		if err != nil {
+			t.Fatalf("creating settings: %v", err)
+		}
+
+		return created
+	}
+
+	if created := create(t, newDomain(), nil); !created.Discoverable {
+		t.Fatal("without a template settings must be discoverable")
+	}
+
+	hidden := false
+	if created := create(t, newDomain(), &hidden); created.Discoverable {
+		t.Fatal("an explicit value must be kept")
+	}
+
+	domainID := newDomain()
+	if _, err := db.Master().Exec(ctx, "INSERT INTO im_contact.settings_template (domain_id, discoverable) VALUES ($1, false)", domainID); err != nil {
+		t.Fatalf("creating template: %v", err)
+	}
+
+	if created := create(t, domainID, nil); created.Discoverable {
+		t.Fatal("the template value must be taken when the caller didn't choose one")
+	}
+}
+
+func TestSettingsUpdateReturnsReplacedValues(t *testing.T) {
+	ctx := context.Background()
+
+	settings, err := postgres.NewSettingsStore(slog.New(slog.DiscardHandler), db)
+	if err != nil {
+		t.Fatalf("settings store: %v", err)
+	}
+
+	contactID := createContact(t, newDomain())
+
+	const updates = 6
+
+	var (
+		wg      sync.WaitGroup
+		mu      sync.Mutex
+		changes = make(map[model.UserFilter]model.UserFilter, updates)
+	)
+
+	// every update sets a distinct filter, so the replaced values must chain from the initial one without repeats
+	for i := 1; i <= updates; i++ {
+		wg.Add(1)
+
+		go func(filter model.UserFilter) {
+			defer wg.Done()
+
+			old, updated, err := settings.Update(ctx, &model.UpdateContactSettingsRequest{ContactID: contactID, AllowInvitesFrom: &filter})
+			if err != nil {
+				t.Errorf("updating settings: %v", err)
+
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if _, seen := changes[old.AllowInvitesFrom]; seen {
+				t.Errorf("two updates replaced the same value %v", old.AllowInvitesFrom)
+			}
+
+			changes[old.AllowInvitesFrom] = updated.AllowInvitesFrom
+		}(model.UserFilter(i % 7))
+	}
+
+	wg.Wait()
+
+	if len(changes) != updates {
+		t.Fatalf("got %d distinct replaced values, want %d", len(changes), updates)
+	}
+}
@@ -0,0 +1,74 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"log/slog"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store/postgres"
+)
+
+func TestSettingsTemplateUpsertEnforcesLockedFields(t *testing.T) {
+	ctx := context.Background()
+
+	settings, err := postgres.NewSettingsStore(slog.New(slog.DiscardHandler), db)
+	if err != nil {
+		t.Fatalf("settings store: %v", err)
+	}
+
+	templates := postgres.NewSettingsTemplateStore(db)
+
+	domainID := newDomain()
+	loose, strict := createContact(t, domainID), createContact(t, domainID)
+
+	all, nobody := model.All, model.Nobody
+	for _, id := range []struct {
+		contact uuid.UUID
+		filter  *model.UserFilter
+	}{{loose, &all}, {strict, &nobody}} {
+		if _, _, err := settings.Update(ctx, &model.UpdateContactSettingsRequest{ContactID: id.contact, AllowMessagesFrom: id.filter, AllowInvitesFrom: &all}); err != nil {
+			t.Fatalf("updating settings: %v", err)
+		}
+	}
+
+	_, enforced, err := templates.Upsert(ctx, &model.SettingsTemplate{
+		DomainID:          domainID,
+		AllowInvitesFrom:  model.Nobody,
+		AllowMessagesFrom: model.Nobody,
+		Discoverable:      true,
+		LockedFields:      []string{model.SettingsFieldAllowMessagesFrom},
+	})
+	if err != nil {
+		t.Fatalf("upserting template: %v", err)
+	}
+
+	if len(enforced) != 1 || enforced[0].Updated.ContactID != loose {
+		t.Fatalf("enforced %d settings, want only the contact with a looser value %v", len(enforced), loose)
+	}
+
+	if old := enforced[0].Old; old.AllowMessagesFrom != model.All || old.ContactID != loose {
+		t.Fatalf("enforced old allow_messages_from = %v, want %v", old.AllowMessagesFrom, model.All)
+	}
+
+	if updated := enforced[0].Updated; updated.AllowMessagesFrom != model.Nobody || updated.UpdatedBy != 0 {
+		t.Fatalf("enforced allow_messages_from = %v by %d, want %v by the system", updated.AllowMessagesFrom, updated.UpdatedBy, model.Nobody)
+	}
+
+	got, err := settings.Get(ctx, loose)
+	if err != nil {
+		t.Fatalf("getting settings: %v", err)
+	}
+
+	if got.AllowMessagesFrom != model.Nobody {
+		t.Fatalf("locked allow_messages_from = %v, want %v", got.AllowMessagesFrom, model.Nobody)
+	}
+
+	if got.AllowInvitesFrom != model.All {
+		t.Fatalf("unlocked allow_invites_from = %v, want the contact value %v", got.AllowInvitesFrom, model.All)
+	}
+}
@@ -0,0 +1,93 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store/postgres"
+)
+
+func TestViaConsentSearchFilter(t *testing.T) {
+	ctx := context.Background()
+
+	vias := postgres.NewViaStore(db)
+	consents := postgres.NewViaConsentStore(db)
+
+	contactID := createContact(t, newDomain())
+
+	const (
+		granted = "granted@example.com"
+		revoked = "revoked@example.com"
+		unknown = "unknown@example.com"
+	)
+
+	for _, via := range []string{granted, revoked, unknown} {
+		createVia(t, vias, contactID, via, model.ViaKindEmail, 0)
+	}
+
+	record := func(via string, status model.ConsentStatus, occurredAt time.Time) {
+		t.Helper()
+
+		if _, err := consents.Record(ctx, &model.ChangeViaConsentCommand{
+			ContactID:  contactID,
+			Via:        via,
+			Status:     status,
+			Source:     "test",
+			LegalBasis: "consent",
+			OccurredAt: occurredAt,
+		}); err != nil {
+			t.Fatalf("recording consent of %s: %v", via, err)
+		}
+	}
+
+	now := time.Now()
+
+	record(granted, model.ConsentStatusRevoked, now.Add(-2*time.Hour))
+	record(granted, model.ConsentStatusGranted, now.Add(-time.Hour))
+	// recorded later, but the revocation occurred last
+	record(revoked, model.ConsentStatusRevoked, now.Add(-time.Hour))
+	record(revoked, model.ConsentStatusGranted, now.Add(-2*time.Hour))
+
+	for filter, want := range map[model.ConsentFilter][]string{
+		model.ConsentFilterGranted: {granted},
+		model.ConsentFilterRevoked: {revoked},
+		model.ConsentFilterUnknown: {unknown},
+		model.ConsentFilterAny:     {granted, revoked, unknown},
+	} {
+		found, err := vias.Search(ctx, &model.SearchViaCommunicationsFilter{ContactIDs: []uuid.UUID{contactID}, Consent: filter})
+		if err != nil {
+			t.Fatalf("searching with consent %q: %v", filter, err)
+		}
+
+		got := make([]string, 0, len(found))
+		for _, v := range found {
+			got = append(got, v.Via)
+		}
+
+		slices.Sort(got)
+
+		if !slices.Equal(got, want) {
+			t.Fatalf("consent %q found %v, want %v", filter, got, want)
+		}
+	}
+
+	if _, err := vias.Delete(ctx, &model.DeleteViaCommand{ContactID: contactID, Via: granted}); err != nil {
+		t.Fatalf("deleting via: %v", err)
+	}
+
+	history, err := consents.History(ctx, contactID, granted)
+	if err != nil {
+		t.Fatalf("reading history: %v", err)
+	}
+
+	if len(history) != 2 {
+		t.Fatalf("history of a deleted via has %d records, want 2", len(history))
+	}
+}
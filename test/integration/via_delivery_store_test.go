@@ -0,0 +1,103 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store/postgres"
+)
+
+func TestRegisterDeliveryFailureThreshold(t *testing.T) {
+	ctx := context.Background()
+
+	vias := postgres.NewViaStore(db)
+	contactID := createContact(t, newDomain())
+
+	const address = "bounced@example.com"
+
+	createVia(t, vias, contactID, address, model.ViaKindEmail, 0)
+
+	if _, err := vias.Update(ctx, &model.ViaCommunication{ContactID: contactID, Via: address, Kind: model.ViaKindEmail, UpdatedBy: 7}); err != nil {
+		t.Fatalf("updating via: %v", err)
+	}
+
+	policy := model.DeliveryFailurePolicy{DisableThreshold: 3}
+
+	for i := 1; i <= 4; i++ {
+		result, err := vias.RegisterDeliveryFailure(ctx, &model.RegisterDeliveryFailureCommand{
+			ContactID: contactID,
+			Via:       address,
+			FailedAt:  time.Now().UTC(),
+		}, policy)
+		if err != nil {
+			t.Fatalf("registering failure %d: %v", i, err)
+		}
+
+		if result.Via.FailureCount != i {
+			t.Fatalf("failure %d counted as %d", i, result.Via.FailureCount)
+		}
+
+		// only the failure crossing the threshold disables the via
+		if result.Disabled != (i == policy.DisableThreshold) {
+			t.Fatalf("failure %d: disabled = %t", i, result.Disabled)
+		}
+
+		if result.Via.Disable != (i >= policy.DisableThreshold) {
+			t.Fatalf("failure %d: via disable = %t", i, result.Via.Disable)
+		}
+
+		if result.Via.UpdatedBy != 0 {
+			t.Fatalf("failure %d: updated by %d, want the system", i, result.Via.UpdatedBy)
+		}
+	}
+
+	if _, err := vias.RegisterDeliveryFailure(ctx, &model.RegisterDeliveryFailureCommand{
+		ContactID: contactID,
+		Via:       "unknown@example.com",
+		FailedAt:  time.Now().UTC(),
+	}, policy); err == nil {
+		t.Fatal("expected an error for an unknown via")
+	}
+}
+
+func TestReenableExpired(t *testing.T) {
+	ctx := context.Background()
+
+	vias := postgres.NewViaStore(db)
+	contactID := createContact(t, newDomain())
+
+	const address = "suspended@example.com"
+
+	createVia(t, vias, contactID, address, model.ViaKindEmail, 0)
+
+	until := time.Now().Add(time.Hour)
+	if _, err := vias.Update(ctx, &model.ViaCommunication{
+		ContactID:     contactID,
+		Via:           address,
+		Kind:          model.ViaKindEmail,
+		Disable:       true,
+		DisabledUntil: &until,
+		UpdatedBy:     7,
+	}); err != nil {
+		t.Fatalf("suspending via: %v", err)
+	}
+
+	reenabled, err := vias.ReenableExpired(ctx, until.Add(time.Minute), 1000)
+	if err != nil {
+		t.Fatalf("reenabling: %v", err)
+	}
+
+	idx := slices.IndexFunc(reenabled, func(v *model.ViaCommunication) bool { return v.ContactID == contactID })
+	if idx < 0 {
+		t.Fatal("suspended via was not reenabled")
+	}
+
+	if v := reenabled[idx]; v.Disable || v.DisabledUntil != nil || v.UpdatedBy != 0 {
+		t.Fatalf("reenabled via: disable = %t, disabled until = %v, updated by %d", v.Disable, v.DisabledUntil, v.UpdatedBy)
+	}
+}
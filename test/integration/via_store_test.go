@@ -0,0 +1,280 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+
+	"github.com/webitel/webitel-go-kit/pkg/errors"
+
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store"
+	"github.com/webitel/im-contact-service/internal/store/postgres"
+)
+
+// createVia inserts a via of the contact through the store.
+func createVia(t *testing.T, vias store.ViaStore, contactID uuid.UUID, via string, kind model.ViaKind, priority int) {
+	t.Helper()
+
+	if _, err := vias.Create(context.Background(), &model.CreateViaCommunicationCommand{ContactID: contactID, Via: via, Kind: kind, Priority: priority}); err != nil {
+		t.Fatalf("creating via %s: %v", via, err)
+	}
+}
+
+// primaries returns the primary vias of the contact by kind.
+func primaries(t *testing.T, vias store.ViaStore, contactID uuid.UUID) map[model.ViaKind]string {
+	t.Helper()
+
+	found, err := vias.Search(context.Background(), &model.SearchViaCommunicationsFilter{ContactIDs: []uuid.UUID{contactID}})
+	if err != nil {
+		t.Fatalf("searching vias: %v", err)
+	}
+
+	primary := make(map[model.ViaKind]string)
+	for _, v := range found {
+		if v.Primary {
+			if other, ok := primary[v.Kind]; ok {
+				t.Fatalf("kind %s has two primary vias: %s and %s", v.Kind, other, v.Via)
+			}
+
+			primary[v.Kind] = v.Via
+		}
+	}
+
+	return primary
+}
+
+func TestViaPrimaryHandoff(t *testing.T) {
+	ctx := context.Background()
+	vias := postgres.NewViaStore(db)
+
+	contactID := createContact(t, newDomain())
+
+	const (
+		first  = "+4915100000001"
+		second = "+4915100000002"
+		third  = "+4915100000003"
+	)
+
+	createVia(t, vias, contactID, first, model.ViaKindPhone, 1)
+	createVia(t, vias, contactID, second, model.ViaKindPhone, 0)
+	createVia(t, vias, contactID, third, model.ViaKindPhone, 2)
+
+	if _, err := vias.SetPrimary(ctx, &model.SetPrimaryViaCommand{ContactID: contactID, Via: first}); err != nil {
+		t.Fatalf("setting first primary: %v", err)
+	}
+
+	set, err := vias.SetPrimary(ctx, &model.SetPrimaryViaCommand{ContactID: contactID, Via: second})
+	if err != nil {
+		t.Fatalf("setting second primary: %v", err)
+	}
+
+	if len(set.Changed) != 2 || set.Primary.Via != second {
+		t.Fatalf("expected the second via promoted and the first demoted, got %d changes", len(set.Changed))
+	}
+
+	if got := primaries(t, vias, contactID)[model.ViaKindPhone]; got != second {
+		t.Fatalf("primary phone = %q, want %q", got, second)
+	}
+
+	// moving the primary via to another kind hands its flag to the next phone by priority
+	updated, err := vias.PartialUpdate(ctx, &model.CommunicationViaPartialUpdateCmd{
+		ViaCommunication: model.ViaCommunication{ContactID: contactID, Via: second, Kind: model.ViaKindWhatsApp},
+		Fields:           []string{"kind"},
+	})
+	if err != nil {
+		t.Fatalf("changing kind of the primary via: %v", err)
+	}
+
+	if updated.Via.Primary || updated.Promoted == nil || updated.Promoted.Via != first {
+		t.Fatalf("kind change: primary = %v, promoted = %v, want %s promoted", updated.Via.Primary, updated.Promoted, first)
+	}
+
+	deleted, err := vias.Delete(ctx, &model.DeleteViaCommand{ContactID: contactID, Via: first})
+	if err != nil {
+		t.Fatalf("deleting the primary via: %v", err)
+	}
+
+	if deleted.Promoted == nil || deleted.Promoted.Via != third {
+		t.Fatalf("delete promoted %v, want %s", deleted.Promoted, third)
+	}
+
+	if deleted, err = vias.Delete(ctx, &model.DeleteViaCommand{ContactID: contactID, Via: third}); err != nil || deleted.Promoted != nil {
+		t.Fatalf("deleting the last phone: promoted = %v, err = %v", deleted, err)
+	}
+
+	if got := primaries(t, vias, contactID); len(got) != 0 {
+		t.Fatalf("expected no primary vias left, got %v", got)
+	}
+}
+
+func TestViaReplace(t *testing.T) {
+	t.Run("matches stored vias", testViaReplaceMatchesStoredVias)
+	t.Run("hands the primary flag over", testViaReplacePrimaryHandoff)
+}
+
+func testViaReplaceMatchesStoredVias(t *testing.T) {
+	ctx := context.Background()
+	vias := postgres.NewViaStore(db)
+
+	contactID := createContact(t, newDomain())
+
+	const (
+		whatsapp = "+4915100000010"
+		custom   = "12345678901"
+		old      = "old@example.com"
+	)
+
+	createVia(t, vias, contactID, whatsapp, model.ViaKindWhatsApp, 0)
+	createVia(t, vias, contactID, custom, model.ViaKindCustom, 0)
+	createVia(t, vias, contactID, old, model.ViaKindEmail, 0)
+
+	if _, err := vias.MarkVerified(ctx, contactID, whatsapp, 0); err != nil {
+		t.Fatalf("verifying via: %v", err)
+	}
+
+	items := func() []*model.ReplaceViaItem {
+		return []*model.ReplaceViaItem{
+			{Via: "+49 151 0000 0010"},
+			{Via: custom, Priority: 5},
+			{Via: "New@Example.com", Kind: model.ViaKindEmail},
+		}
+	}
+
+	result, err := vias.Replace(ctx, &model.ReplaceViasCommand{ContactID: contactID, Items: items()})
+	if err != nil {
+		t.Fatalf("replacing: %v", err)
+	}
+
+	if len(result.Created) != 1 || result.Created[0].Via != "new@example.com" {
+		t.Fatalf("created %v, want new@example.com", result.Created)
+	}
+
+	if len(result.Updated) != 1 || result.Updated[0].Via != custom || result.Updated[0].Priority != 5 {
+		t.Fatalf("updated %v, want the priority of %s", result.Updated, custom)
+	}
+
+	if len(result.Deleted) != 1 || result.Deleted[0].Via != old {
+		t.Fatalf("deleted %v, want %s", result.Deleted, old)
+	}
+
+	kinds := make(map[string]*model.ViaCommunication, len(result.Vias))
+	for _, v := range result.Vias {
+		kinds[v.Via] = v
+	}
+
+	if v := kinds[whatsapp]; v == nil || v.Kind != model.ViaKindWhatsApp || v.VerifiedAt == nil {
+		t.Fatalf("whatsapp via was not kept as is: %+v", v)
+	}
+
+	if v := kinds[custom]; v == nil || v.Kind != model.ViaKindCustom {
+		t.Fatalf("custom via was not kept as is: %+v", v)
+	}
+
+	if result, err = vias.Replace(ctx, &model.ReplaceViasCommand{ContactID: contactID, Items: items()}); err != nil {
+		t.Fatalf("replacing again: %v", err)
+	}
+
+	if len(result.Created)+len(result.Updated)+len(result.Deleted) != 0 {
+		t.Fatalf("repeated replace changed vias: %+v", result)
+	}
+
+	// a rejected plan rolls the whole replace back
+	_, err = vias.Replace(ctx, &model.ReplaceViasCommand{ContactID: contactID, Items: []*model.ReplaceViaItem{
+		{Via: whatsapp},
+		{Via: "+49 151 0000 0010"},
+	}})
+	if errors.Code(err) != codes.InvalidArgument {
+		t.Fatalf("duplicate items: err = %v, want InvalidArgument", err)
+	}
+
+	found, err := vias.Search(ctx, &model.SearchViaCommunicationsFilter{ContactIDs: []uuid.UUID{contactID}})
+	if err != nil {
+		t.Fatalf("searching vias: %v", err)
+	}
+
+	if len(found) != len(result.Vias) {
+		t.Fatalf("rejected replace left %d vias, want %d", len(found), len(result.Vias))
+	}
+
+	if _, err = vias.Replace(ctx, &model.ReplaceViasCommand{ContactID: uuid.New(), Items: items()}); errors.Code(err) != codes.NotFound {
+		t.Fatalf("unknown contact: err = %v, want NotFound", err)
+	}
+}
+
+func testViaReplacePrimaryHandoff(t *testing.T) {
+	ctx := context.Background()
+	vias := postgres.NewViaStore(db)
+
+	contactID := createContact(t, newDomain())
+
+	const (
+		dropped = "+4915100000021"
+		phone   = "+4915100000022"
+		rekind  = "first@example.com"
+		email   = "second@example.com"
+	)
+
+	createVia(t, vias, contactID, dropped, model.ViaKindPhone, 0)
+	createVia(t, vias, contactID, phone, model.ViaKindPhone, 1)
+	createVia(t, vias, contactID, rekind, model.ViaKindEmail, 0)
+	createVia(t, vias, contactID, email, model.ViaKindEmail, 1)
+
+	for _, via := range []string{dropped, rekind} {
+		if _, err := vias.SetPrimary(ctx, &model.SetPrimaryViaCommand{ContactID: contactID, Via: via}); err != nil {
+			t.Fatalf("setting %s primary: %v", via, err)
+		}
+	}
+
+	result, err := vias.Replace(ctx, &model.ReplaceViasCommand{ContactID: contactID, Items: []*model.ReplaceViaItem{
+		{Via: phone, Priority: 1},
+		{Via: rekind, Kind: model.ViaKindCustom},
+		{Via: email, Priority: 1},
+	}})
+	if err != nil {
+		t.Fatalf("replacing: %v", err)
+	}
+
+	got := primaries(t, vias, contactID)
+	if got[model.ViaKindPhone] != phone || got[model.ViaKindEmail] != email {
+		t.Fatalf("primaries = %v, want %s and %s", got, phone, email)
+	}
+
+	if _, ok := got[model.ViaKindCustom]; ok {
+		t.Fatalf("re-kinded via kept its primary flag: %v", got)
+	}
+
+	updated := make(map[string]*model.ViaCommunication, len(result.Updated))
+	for _, v := range result.Updated {
+		updated[v.Via] = v
+	}
+
+	// the promoted vias are reported as updated, so via updated is published for them
+	for _, via := range []string{phone, email} {
+		if v := updated[via]; v == nil || !v.Primary {
+			t.Fatalf("promoted via %s is not among the updated ones: %v", via, result.Updated)
+		}
+	}
+
+	if v := updated[rekind]; v == nil || v.Primary || v.Kind != model.ViaKindCustom {
+		t.Fatalf("re-kinded via: %+v", v)
+	}
+}
+
+func TestViaValueHasOneKind(t *testing.T) {
+	vias := postgres.NewViaStore(db)
+	contactID := createContact(t, newDomain())
+
+	const number = "+4915100000031"
+
+	createVia(t, vias, contactID, number, model.ViaKindPhone, 0)
+
+	_, err := vias.Create(context.Background(), &model.CreateViaCommunicationCommand{ContactID: contactID, Via: number, Kind: model.ViaKindWhatsApp})
+	if errors.Code(err) != codes.AlreadyExists {
+		t.Fatalf("the same number as whatsapp: err = %v, want AlreadyExists", err)
+	}
+}
@@ -0,0 +1,85 @@
+//go:build integration
+
+package integration
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/webitel/im-contact-service/internal/model"
+	"github.com/webitel/im-contact-service/internal/store/postgres"
+)
+
+func TestViaVerificationReserveAttempt(t *testing.T) {
+	ctx := context.Background()
+	verifications := postgres.NewViaVerificationStore(db)
+
+	const (
+		via         = "user@example.com"
+		maxAttempts = 3
+	)
+
+	contactID := createContact(t, newDomain())
+	if _, err := db.Master().Exec(ctx, "INSERT INTO im_contact.via (contact_id, via, kind) VALUES ($1, $2, 'email')", contactID, via); err != nil {
+		t.Fatalf("creating via: %v", err)
+	}
+
+	pending := &model.ViaVerification{
+		ContactID:   contactID,
+		Via:         via,
+		CodeHash:    []byte("hash"),
+		Salt:        []byte("salt"),
+		MaxAttempts: maxAttempts,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	if saved, err := verifications.Save(ctx, pending, time.Minute); err != nil || saved == nil {
+		t.Fatalf("saving verification: %v", err)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		reserved atomic.Int32
+	)
+
+	for range 20 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			verification, err := verifications.ReserveAttempt(ctx, contactID, via)
+			if err != nil {
+				t.Errorf("reserving attempt: %v", err)
+
+				return
+			}
+
+			if verification != nil {
+				reserved.Add(1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got := reserved.Load(); got != maxAttempts {
+		t.Fatalf("reserved %d attempts, want %d", got, maxAttempts)
+	}
+
+	// an early resend must neither replace the code nor give the attempts back
+	if saved, err := verifications.Save(ctx, pending, time.Minute); err != nil || saved != nil {
+		t.Fatalf("early resend: saved = %v, err = %v", saved, err)
+	}
+
+	if verification, err := verifications.ReserveAttempt(ctx, contactID, via); err != nil || verification != nil {
+		t.Fatalf("exhausted verification: reserved = %v, err = %v", verification, err)
+	}
+
+	if saved, err := verifications.Save(ctx, pending, 0); err != nil || saved == nil || saved.Attempts != 0 {
+		t.Fatalf("resend: saved = %v, err = %v", saved, err)
+	}
+}